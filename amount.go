@@ -4,12 +4,15 @@
 package currency
 
 import (
-	"bytes"
 	"database/sql/driver"
-	"encoding/json"
+	"encoding/binary"
 	"fmt"
+	"io"
+	"math"
 	"math/big"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/cockroachdb/apd/v3"
 )
@@ -49,6 +52,35 @@ func (e InvalidCurrencyCodeError) Error() string {
 	return fmt.Sprintf("invalid currency code %q", e.CurrencyCode)
 }
 
+// InvalidBinaryError is returned when binary data can't be decoded into an amount.
+type InvalidBinaryError struct {
+	Reason string
+}
+
+func (e InvalidBinaryError) Error() string {
+	return fmt.Sprintf("invalid binary data: %v", e.Reason)
+}
+
+// InvalidUnitsError is returned when units and nanos don't represent a valid amount.
+type InvalidUnitsError struct {
+	Units  int64
+	Nanos  int32
+	Reason string
+}
+
+func (e InvalidUnitsError) Error() string {
+	return fmt.Sprintf("invalid units %d and nanos %d: %v", e.Units, e.Nanos, e.Reason)
+}
+
+// UnitsOverflowError is returned when an amount can't be represented as units and nanos.
+type UnitsOverflowError struct {
+	A Amount
+}
+
+func (e UnitsOverflowError) Error() string {
+	return fmt.Sprintf("amount %q can't be represented as units and nanos", e.A)
+}
+
 // MismatchError is returned when two amounts have mismatched currency codes.
 type MismatchError struct {
 	A Amount
@@ -59,6 +91,20 @@ func (e MismatchError) Error() string {
 	return fmt.Sprintf("amounts %q and %q have mismatched currency codes", e.A, e.B)
 }
 
+// InvalidRatiosError is returned when an amount can't be allocated by the given ratios.
+//
+// The ratios must be non-empty, non-negative, and not all zero.
+type InvalidRatiosError struct {
+	Ratios []string
+}
+
+func (e InvalidRatiosError) Error() string {
+	if len(e.Ratios) == 0 {
+		return "no ratios given"
+	}
+	return fmt.Sprintf("invalid ratios %q", e.Ratios)
+}
+
 // Amount stores a decimal number with its currency code.
 type Amount struct {
 	number       apd.Decimal
@@ -105,6 +151,48 @@ func NewAmountFromInt64(n int64, currencyCode string) (Amount, error) {
 	return Amount{number, currencyCode}, nil
 }
 
+// nanosPerUnit is the number of nanos in a unit.
+const nanosPerUnit = 1000000000
+
+// NewAmountFromUnits creates a new Amount from units, nanos and a currency code.
+//
+// Units are whole units of the currency, and nanos are billionths of a unit
+// (e.g. 12 units and 500000000 nanos for "12.50 USD"), matching the fields of
+// google.type.Money. The nanos must be between -999999999 and 999999999,
+// with the same sign as the units, or an InvalidUnitsError is returned.
+// Trailing zeroes are removed down to the currency's number of digits.
+func NewAmountFromUnits(units int64, nanos int32, currencyCode string) (Amount, error) {
+	if nanos <= -nanosPerUnit || nanos >= nanosPerUnit {
+		return Amount{}, InvalidUnitsError{units, nanos, "nanos out of range"}
+	}
+	if (units > 0 && nanos < 0) || (units < 0 && nanos > 0) {
+		return Amount{}, InvalidUnitsError{units, nanos, "units and nanos have different signs"}
+	}
+	d, ok := GetDigits(currencyCode)
+	if !ok {
+		return Amount{}, InvalidCurrencyCodeError{currencyCode}
+	}
+	coeff := big.NewInt(units)
+	coeff.Mul(coeff, big.NewInt(nanosPerUnit))
+	coeff.Add(coeff, big.NewInt(int64(nanos)))
+	exponent := int32(-9)
+	ten := big.NewInt(10)
+	quo, rem := new(big.Int), new(big.Int)
+	for exponent < -int32(d) {
+		quo.QuoRem(coeff, ten, rem)
+		if rem.Sign() != 0 {
+			break
+		}
+		coeff.Set(quo)
+		exponent++
+	}
+	number := apd.Decimal{Exponent: exponent}
+	number.Coeff.SetMathBigInt(coeff.Abs(coeff))
+	number.Negative = units < 0 || nanos < 0
+
+	return Amount{number, currencyCode}, nil
+}
+
 // Number returns the number as a numeric string.
 func (a Amount) Number() string {
 	return a.number.String()
@@ -116,8 +204,81 @@ func (a Amount) CurrencyCode() string {
 }
 
 // String returns the string representation of a.
+//
+// The number is always in fixed-point notation ("100 EUR", never "1E+2 EUR").
 func (a Amount) String() string {
-	return a.Number() + " " + a.CurrencyCode()
+	return a.number.Text('f') + " " + a.CurrencyCode()
+}
+
+// Format implements the fmt.Formatter interface.
+//
+// Supported verbs:
+//
+//	%v, %s  number and currency code ("10.99 USD")
+//	%q      quoted number and currency code ("\"10.99 USD\"")
+//	%f      number only ("10.99")
+//	%d      number in minor units, as returned by BigInt() ("1099")
+//
+// The number is always written in fixed-point notation ("1000", not "1E+3"),
+// and negative zero is written as zero.
+// The precision, if given, specifies the number of fraction digits for
+// all verbs except %d, rounding the number as RoundTo(precision, RoundHalfUp).
+// For example, "%.2f" formats "10.995 USD" as "11.00".
+// The width and the '-', '+', ' ' and '0' flags behave as they do for numbers,
+// with the '0' flag only applying to %f and %d.
+func (a Amount) Format(s fmt.State, verb rune) {
+	if verb == 'd' {
+		a.BigInt().Format(s, 'd')
+		return
+	}
+	if prec, ok := s.Precision(); ok && prec >= 0 && prec < int(DefaultDigits) {
+		a = a.RoundTo(uint8(prec), RoundHalfUp)
+	}
+
+	// Always use fixed-point notation, and drop the sign of negative zero.
+	n := a.number.Text('f')
+	negative := strings.HasPrefix(n, "-")
+	n = strings.TrimPrefix(n, "-")
+	sign := ""
+	switch {
+	case negative && !a.number.IsZero():
+		sign = "-"
+	case s.Flag('+'):
+		sign = "+"
+	case s.Flag(' '):
+		sign = " "
+	}
+	var formatted string
+	zeroPadding := false
+	switch verb {
+	case 'v', 's':
+		formatted = sign + n + " " + a.currencyCode
+	case 'q':
+		formatted = strconv.Quote(sign + n + " " + a.currencyCode)
+	case 'f':
+		formatted = sign + n
+		zeroPadding = s.Flag('0') && !s.Flag('-')
+	default:
+		fmt.Fprintf(s, "%%!%c(currency.Amount=%s)", verb, a.String())
+		return
+	}
+
+	width, ok := s.Width()
+	if !ok || width <= utf8.RuneCountInString(formatted) {
+		io.WriteString(s, formatted)
+		return
+	}
+	padding := width - utf8.RuneCountInString(formatted)
+	switch {
+	case s.Flag('-'):
+		formatted = formatted + strings.Repeat(" ", padding)
+	case zeroPadding:
+		// Zeroes go between the sign and the digits.
+		formatted = sign + strings.Repeat("0", padding) + n
+	default:
+		formatted = strings.Repeat(" ", padding) + formatted
+	}
+	io.WriteString(s, formatted)
 }
 
 // BigInt returns a in minor units, as a big.Int.
@@ -141,6 +302,38 @@ func (a Amount) Int64() (int64, error) {
 	return n.Int64()
 }
 
+// Units returns a as units and nanos.
+//
+// Units are whole units of the currency, and nanos are billionths of a unit
+// with the same sign as the units (e.g. -12 units and -500000000 nanos for
+// "-12.50 USD"), matching the fields of google.type.Money.
+// If a has more than 9 non-zero fraction digits, or its units can't be
+// represented in an int64, a UnitsOverflowError is returned.
+func (a Amount) Units() (units int64, nanos int32, err error) {
+	var n *big.Int
+	if a.number.Exponent >= -9 {
+		n = scaleCoefficient(&a.number, -9)
+	} else {
+		n = a.number.Coeff.MathBigInt()
+		scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-9-a.number.Exponent)), nil)
+		rem := new(big.Int)
+		if n.QuoRem(n, scale, rem); rem.Sign() != 0 {
+			return 0, 0, UnitsOverflowError{a}
+		}
+		if a.number.Negative {
+			n.Neg(n)
+		}
+	}
+	// QuoRem truncates towards zero, giving units and nanos the same sign.
+	rem := new(big.Int)
+	n.QuoRem(n, big.NewInt(nanosPerUnit), rem)
+	if !n.IsInt64() {
+		return 0, 0, UnitsOverflowError{a}
+	}
+
+	return n.Int64(), int32(rem.Int64()), nil
+}
+
 // Convert converts a to a different currency.
 func (a Amount) Convert(currencyCode, rate string) (Amount, error) {
 	if currencyCode == "" || !IsValid(currencyCode) {
@@ -223,6 +416,90 @@ func (a Amount) Div(n string) (Amount, error) {
 	return Amount{result, a.currencyCode}, nil
 }
 
+// Allocate splits a into parts proportional to the given ratios.
+//
+// Ratios are numeric strings, and can be integers ("70", "30")
+// or decimals ("0.7", "0.3"). Negative ratios are not allowed.
+// Each part is rounded towards zero to the currency's fraction digits
+// (or to a's own precision, if greater), and the remainder is distributed
+// one minor unit at a time, starting from the first part with a non-zero ratio.
+// The parts are guaranteed to add up to a.
+func (a Amount) Allocate(ratios ...string) ([]Amount, error) {
+	if len(ratios) == 0 {
+		return nil, InvalidRatiosError{ratios}
+	}
+	parsedRatios := make([]apd.Decimal, len(ratios))
+	minExponent := int32(0)
+	for i, r := range ratios {
+		if _, _, err := parsedRatios[i].SetString(r); err != nil {
+			return nil, InvalidNumberError{r}
+		}
+		if parsedRatios[i].Negative || parsedRatios[i].Form != apd.Finite {
+			return nil, InvalidRatiosError{ratios}
+		}
+		if parsedRatios[i].Exponent < minExponent {
+			minExponent = parsedRatios[i].Exponent
+		}
+	}
+	// Convert the ratios to integers with a common scale.
+	scaledRatios := make([]*big.Int, len(ratios))
+	ratioSum := new(big.Int)
+	for i := range parsedRatios {
+		scaledRatios[i] = scaleCoefficient(&parsedRatios[i], minExponent)
+		ratioSum.Add(ratioSum, scaledRatios[i])
+	}
+	if ratioSum.Sign() == 0 {
+		return nil, InvalidRatiosError{ratios}
+	}
+
+	// Allocate in minor units, or in a's own smallest unit if finer.
+	digits, _ := GetDigits(a.currencyCode)
+	exponent := -int32(digits)
+	if a.number.Exponent < exponent {
+		exponent = a.number.Exponent
+	}
+	total := scaleCoefficient(&a.number, exponent)
+	remainder := new(big.Int).Set(total)
+	shares := make([]*big.Int, len(ratios))
+	for i, r := range scaledRatios {
+		shares[i] = new(big.Int).Mul(total, r)
+		// Quo truncates towards zero, which keeps negative amounts symmetrical.
+		shares[i].Quo(shares[i], ratioSum)
+		remainder.Sub(remainder, shares[i])
+	}
+	unit := big.NewInt(int64(remainder.Sign()))
+	for i := 0; remainder.Sign() != 0; i++ {
+		if scaledRatios[i].Sign() == 0 {
+			continue
+		}
+		shares[i].Add(shares[i], unit)
+		remainder.Sub(remainder, unit)
+	}
+
+	parts := make([]Amount, len(shares))
+	for i, share := range shares {
+		coeff := new(apd.BigInt).SetMathBigInt(share)
+		parts[i] = Amount{*apd.NewWithBigInt(coeff, exponent), a.currencyCode}
+	}
+
+	return parts, nil
+}
+
+// Split splits a into n parts that are as equal as possible.
+//
+// It is a shortcut for calling Allocate with n equal ratios.
+func (a Amount) Split(n int) ([]Amount, error) {
+	if n < 1 {
+		return nil, InvalidRatiosError{}
+	}
+	ratios := make([]string, n)
+	for i := range ratios {
+		ratios[i] = "1"
+	}
+
+	return a.Allocate(ratios...)
+}
+
 // Round is a shortcut for RoundTo(currency.DefaultDigits, currency.RoundHalfUp).
 func (a Amount) Round() Amount {
 	return a.RoundTo(DefaultDigits, RoundHalfUp)
@@ -241,6 +518,77 @@ func (a Amount) RoundTo(digits uint8, mode RoundingMode) Amount {
 	return Amount{result, a.currencyCode}
 }
 
+// RoundCash rounds a using the cash rounding rules of its currency.
+//
+// For example, CHF amounts are rounded to the nearest 0.05, DKK amounts
+// to the nearest 0.50, and SEK amounts to the nearest whole krona.
+// Currencies without special cash rules are rounded to their fraction digits.
+func (a Amount) RoundCash() Amount {
+	if a.currencyCode == "" {
+		return a.Round()
+	}
+	return a.roundToIncrement(cashIncrement(a.currencyCode), RoundHalfUp)
+}
+
+// RoundToIncrement rounds a to the nearest multiple of the given increment.
+//
+// The increment is a numeric string, such as "0.05" or "0.50".
+// The result has the same number of fraction digits as the increment.
+func (a Amount) RoundToIncrement(increment string, mode RoundingMode) (Amount, error) {
+	inc := apd.Decimal{}
+	if _, _, err := inc.SetString(increment); err != nil {
+		return Amount{}, InvalidNumberError{increment}
+	}
+	if inc.Form != apd.Finite || inc.Sign() != 1 {
+		return Amount{}, InvalidNumberError{increment}
+	}
+
+	return a.roundToIncrement(&inc, mode), nil
+}
+
+// roundToIncrement rounds a to the nearest multiple of the given positive increment.
+func (a Amount) roundToIncrement(inc *apd.Decimal, mode RoundingMode) Amount {
+	exponent := inc.Exponent
+	if a.number.Exponent < exponent {
+		exponent = a.number.Exponent
+	}
+	n := scaleCoefficient(&a.number, exponent)
+	step := scaleCoefficient(inc, exponent)
+	q, r := new(big.Int).QuoRem(n, step, new(big.Int))
+	if r.Sign() != 0 {
+		// Compare the remainder to half of the increment.
+		half := new(big.Int).Abs(r)
+		half.Lsh(half, 1)
+		halfCmp := half.Cmp(step)
+		awayFromZero := false
+		switch mode {
+		case RoundHalfUp:
+			awayFromZero = halfCmp >= 0
+		case RoundHalfDown:
+			awayFromZero = halfCmp > 0
+		case RoundUp:
+			awayFromZero = true
+		case RoundDown:
+			awayFromZero = false
+		case RoundHalfEven:
+			awayFromZero = halfCmp > 0 || (halfCmp == 0 && q.Bit(0) == 1)
+		}
+		if awayFromZero {
+			q.Add(q, big.NewInt(int64(n.Sign())))
+		}
+	}
+	// Whole number increments such as "5E+1" must not produce a positive
+	// exponent, which would be formatted as "0E+1".
+	resultExponent := inc.Exponent
+	if resultExponent > 0 {
+		resultExponent = 0
+	}
+	q.Mul(q, scaleCoefficient(inc, resultExponent))
+	coeff := new(apd.BigInt).SetMathBigInt(q)
+
+	return Amount{*apd.NewWithBigInt(coeff, resultExponent), a.currencyCode}
+}
+
 // Cmp compares a and b and returns:
 //
 //	-1 if a <  b
@@ -279,17 +627,56 @@ func (a Amount) IsZero() bool {
 	return a.number.Cmp(zero) == 0
 }
 
+// binaryVersion is the version of the binary format written by MarshalBinary.
+//
+// Version bytes are below 0x20, so they can't be confused with the first
+// letter of a currency code, which starts the legacy format ("USD3.45").
+const binaryVersion byte = 1
+
 // MarshalBinary implements the encoding.BinaryMarshaler interface.
+//
+// The binary format is compact, intended for cache keys and queues:
+//
+//	version      byte     (currently 1)
+//	numeric code uvarint  (ISO 4217, e.g. 840 for USD, 0 for the zero value)
+//	exponent     varint
+//	coefficient  uvarint  (coefficient<<2 | sign), or, for coefficients
+//	                      of 62 bits or more, (length<<2 | 2 | sign)
+//	                      followed by the big-endian coefficient bytes
+//
+// For example, "3.45 USD" is encoded in 6 bytes.
 func (a Amount) MarshalBinary() ([]byte, error) {
-	buf := bytes.Buffer{}
-	buf.WriteString(a.CurrencyCode())
-	buf.WriteString(a.Number())
+	numericCode := 0
+	if a.currencyCode != "" {
+		numericCode, _ = strconv.Atoi(currencies[a.currencyCode].numericCode)
+	}
+	sign := uint64(0)
+	if a.number.Negative {
+		sign = 1
+	}
+	buf := make([]byte, 1+3*binary.MaxVarintLen64)
+	buf[0] = binaryVersion
+	n := 1
+	n += binary.PutUvarint(buf[n:], uint64(numericCode))
+	n += binary.PutVarint(buf[n:], int64(a.number.Exponent))
+	if a.number.Coeff.BitLen() < 62 {
+		n += binary.PutUvarint(buf[n:], a.number.Coeff.Uint64()<<2|sign)
+		return buf[:n], nil
+	}
+	coeff := a.number.Coeff.Bytes()
+	n += binary.PutUvarint(buf[n:], uint64(len(coeff))<<2|2|sign)
 
-	return buf.Bytes(), nil
+	return append(buf[:n], coeff...), nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+//
+// Accepts both the current format and the legacy format, which consisted
+// of the currency code followed by the number ("USD3.45").
 func (a *Amount) UnmarshalBinary(data []byte) error {
+	if len(data) > 0 && data[0] < 0x20 {
+		return a.unmarshalBinaryVersioned(data)
+	}
 	if len(data) < 3 {
 		return InvalidCurrencyCodeError{string(data)}
 	}
@@ -308,46 +695,124 @@ func (a *Amount) UnmarshalBinary(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaler interface.
-func (a Amount) MarshalJSON() ([]byte, error) {
-	return json.Marshal(&struct {
-		Number       string `json:"number"`
-		CurrencyCode string `json:"currency"`
-	}{
-		Number:       a.Number(),
-		CurrencyCode: a.CurrencyCode(),
-	})
+// unmarshalBinaryVersioned decodes data in the format written by MarshalBinary.
+func (a *Amount) unmarshalBinaryVersioned(data []byte) error {
+	if data[0] != binaryVersion {
+		return InvalidBinaryError{fmt.Sprintf("unsupported version %d", data[0])}
+	}
+	data = data[1:]
+	numericCode, n := binary.Uvarint(data)
+	if n <= 0 {
+		return InvalidBinaryError{"invalid numeric code"}
+	}
+	data = data[n:]
+	exponent, n := binary.Varint(data)
+	if n <= 0 || exponent < math.MinInt32 || exponent > math.MaxInt32 {
+		return InvalidBinaryError{"invalid exponent"}
+	}
+	data = data[n:]
+	header, n := binary.Uvarint(data)
+	if n <= 0 {
+		return InvalidBinaryError{"invalid coefficient"}
+	}
+	data = data[n:]
+	number := apd.Decimal{Exponent: int32(exponent), Negative: header&1 == 1}
+	if header&2 == 0 {
+		number.Coeff.SetUint64(header >> 2)
+	} else {
+		length := header >> 2
+		if length > uint64(len(data)) {
+			return InvalidBinaryError{"truncated coefficient"}
+		}
+		number.Coeff.SetBytes(data[:length])
+		data = data[length:]
+	}
+	if len(data) > 0 {
+		return InvalidBinaryError{fmt.Sprintf("%d unexpected trailing bytes", len(data))}
+	}
+	currencyCode := ""
+	if numericCode != 0 {
+		var ok bool
+		currencyCode, ok = forNumericCode(numericCode)
+		if !ok {
+			return InvalidCurrencyCodeError{fmt.Sprintf("%03d", numericCode)}
+		}
+	} else if !number.IsZero() {
+		// Only the zero value is allowed to have an empty currency code.
+		return InvalidCurrencyCodeError{""}
+	}
+	a.number = number
+	a.currencyCode = currencyCode
+
+	return nil
 }
 
-// UnmarshalJSON implements the json.Unmarshaler interface.
-func (a *Amount) UnmarshalJSON(data []byte) error {
-	aux := struct {
-		Number       json.RawMessage `json:"number"`
-		CurrencyCode string          `json:"currency"`
-	}{}
-	err := json.Unmarshal(data, &aux)
-	if err != nil {
-		return err
+// MarshalText implements the encoding.TextMarshaler interface.
+//
+// The canonical text form is the one returned by String(): the number
+// in fixed-point notation, a space, and the currency code ("12.50 EUR").
+// The zero value is marshaled as an empty string.
+func (a Amount) MarshalText() ([]byte, error) {
+	if a.currencyCode == "" && a.number.IsZero() {
+		return []byte{}, nil
 	}
 
-	var auxNumber string
-	if err = json.Unmarshal(aux.Number, &auxNumber); err != nil {
-		auxNumber = string(aux.Number)
-	}
+	return []byte(a.String()), nil
+}
 
-	number := apd.Decimal{}
-	if _, _, err := number.SetString(auxNumber); err != nil {
-		return InvalidNumberError{auxNumber}
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+//
+// In addition to the canonical form ("12.50 EUR"), accepts the currency
+// code before the number ("EUR 12.50", "EUR12.50", "-EUR12.50"), no space
+// between the number and the currency code ("12.50EUR"), lowercase currency
+// codes, and surrounding whitespace. An empty string is unmarshaled
+// as the zero value.
+func (a *Amount) UnmarshalText(text []byte) error {
+	s := strings.TrimSpace(string(text))
+	if s == "" {
+		*a = Amount{}
+		return nil
 	}
-	if aux.CurrencyCode == "" || !IsValid(aux.CurrencyCode) {
-		return InvalidCurrencyCodeError{aux.CurrencyCode}
+	sign := ""
+	if len(s) > 1 && (s[0] == '-' || s[0] == '+') && isAlpha(s[1:2]) {
+		sign, s = s[:1], s[1:]
 	}
-	a.number = number
-	a.currencyCode = aux.CurrencyCode
+	var n, currencyCode string
+	if isAlpha(s[:1]) {
+		i := 1
+		for i < len(s) && isAlpha(s[i:i+1]) {
+			i++
+		}
+		currencyCode, n = s[:i], s[i:]
+	} else {
+		i := len(s)
+		for i > 0 && isAlpha(s[i-1:i]) {
+			i--
+		}
+		n, currencyCode = s[:i], s[i:]
+	}
+	amount, err := NewAmount(sign+strings.TrimSpace(n), strings.ToUpper(currencyCode))
+	if err != nil {
+		return err
+	}
+	*a = amount
 
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaler interface.
+//
+// Amounts are represented as {"number":"3.45","currency":"USD"}.
+// See JSONFormat for other representations.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return JSONFormat{}.Marshal(a)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	return JSONFormat{}.Unmarshal(data, a)
+}
+
 // Value implements the database/driver.Valuer interface.
 //
 // Allows storing amounts in a PostgreSQL composite type.
@@ -357,18 +822,44 @@ func (a Amount) Value() (driver.Value, error) {
 
 // Scan implements the database/sql.Scanner interface.
 //
-// Allows scanning amounts from a PostgreSQL composite type.
+// Allows scanning amounts from a PostgreSQL composite type ("(9.99,USD)"),
+// including quoted and escaped fields (`("9.99","USD")`), as returned
+// by drivers either as a string or a []byte. The parentheses are optional
+// ("9.99,USD"). Values without a comma are parsed as text (see UnmarshalText),
+// allowing amounts to be stored in a single text column ("9.99 USD")
+// in databases without composite types, such as MySQL and SQLite.
+// NULL is scanned as the zero value, use NullAmount to tell the two apart.
 func (a *Amount) Scan(src interface{}) error {
-	// Wire format: "(9.99,USD)".
-	input, ok := src.(string)
-	if !ok {
+	var input string
+	switch src := src.(type) {
+	case string:
+		input = src
+	case []byte:
+		input = string(src)
+	case nil:
+		*a = Amount{}
+		return nil
+	default:
 		return fmt.Errorf("value is not a string: %v", src)
 	}
 	if len(input) == 0 {
 		return nil
 	}
-	input = strings.Trim(input, "()")
-	values := strings.Split(input, ",")
+	if !strings.HasPrefix(input, "(") {
+		if !strings.Contains(input, ",") {
+			return a.UnmarshalText([]byte(input))
+		}
+		// Composite value without the parentheses ("9.99,USD").
+		input = "(" + input + ")"
+	}
+	// Wire format: "(9.99,USD)".
+	values, err := parseComposite(input)
+	if err != nil {
+		return err
+	}
+	if len(values) != 2 {
+		return fmt.Errorf("invalid composite value %q: got %d fields, want 2", input, len(values))
+	}
 	n := values[0]
 	currencyCode := values[1]
 	number := apd.Decimal{}
@@ -391,6 +882,103 @@ func (a *Amount) Scan(src interface{}) error {
 	return nil
 }
 
+// parseComposite parses a PostgreSQL composite value into its fields.
+//
+// Fields are separated by commas, and can be double quoted. Within quotes,
+// a double quote is escaped by doubling it or with a backslash. Outside
+// of quotes, a backslash escapes the next character. Unquoted whitespace
+// around fields is removed.
+func parseComposite(s string) ([]string, error) {
+	if len(s) < 2 || s[0] != '(' || s[len(s)-1] != ')' {
+		return nil, fmt.Errorf("invalid composite value %q", s)
+	}
+	var values []string
+	field := strings.Builder{}
+	quoted, escaped, inQuotes := false, false, false
+	for i := 1; i < len(s)-1; i++ {
+		c := s[i]
+		switch {
+		case escaped:
+			field.WriteByte(c)
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case inQuotes && c == '"':
+			if s[i+1] == '"' {
+				// A doubled quote represents a single quote.
+				field.WriteByte('"')
+				i++
+			} else {
+				inQuotes = false
+			}
+		case inQuotes:
+			field.WriteByte(c)
+		case c == '"':
+			inQuotes, quoted = true, true
+		case c == ',':
+			values = append(values, compositeField(field.String(), quoted))
+			field.Reset()
+			quoted = false
+		default:
+			field.WriteByte(c)
+		}
+	}
+	if inQuotes || escaped {
+		return nil, fmt.Errorf("invalid composite value %q", s)
+	}
+	values = append(values, compositeField(field.String(), quoted))
+
+	return values, nil
+}
+
+// compositeField returns the value of a composite field.
+//
+// Whitespace is trimmed from unquoted fields, keeping the 3 spaces used
+// by an empty currency code in a char(3).
+func compositeField(field string, quoted bool) string {
+	if quoted || strings.TrimSpace(field) == "" {
+		return field
+	}
+
+	return strings.TrimSpace(field)
+}
+
+// NullAmount represents an amount that may be NULL.
+//
+// NullAmount implements the database/sql.Scanner and database/driver.Valuer
+// interfaces, so it can be used as a scan destination, similar to sql.NullString.
+type NullAmount struct {
+	Amount Amount
+	// Valid is true if Amount is not NULL.
+	Valid bool
+}
+
+// Value implements the database/driver.Valuer interface.
+func (n NullAmount) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Amount.Value()
+}
+
+// Scan implements the database/sql.Scanner interface.
+//
+// On error, Amount is reset to the zero value and Valid is set to false.
+func (n *NullAmount) Scan(src interface{}) error {
+	if src == nil {
+		n.Amount, n.Valid = Amount{}, false
+		return nil
+	}
+	if err := n.Amount.Scan(src); err != nil {
+		n.Amount, n.Valid = Amount{}, false
+		return err
+	}
+	n.Valid = true
+
+	return nil
+}
+
 var (
 	decimalContextPrecision19 = apd.BaseContext.WithPrecision(19)
 	decimalContextPrecision39 = apd.BaseContext.WithPrecision(39)
@@ -409,6 +997,22 @@ func decimalContext(decimals ...*apd.Decimal) *apd.Context {
 	return decimalContextPrecision19
 }
 
+// scaleCoefficient returns the coefficient of d rescaled to the given exponent,
+// which must not be greater than the exponent of d.
+// The sign of d is applied to the result.
+func scaleCoefficient(d *apd.Decimal, exponent int32) *big.Int {
+	n := d.Coeff.MathBigInt()
+	if shift := d.Exponent - exponent; shift > 0 {
+		scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(shift)), nil)
+		n.Mul(n, scale)
+	}
+	if d.Negative {
+		n.Neg(n)
+	}
+
+	return n
+}
+
 // roundingContext returns the decimal context to use for rounding.
 // It optimizes for the most common RoundHalfUp mode by returning a preallocated global context for it.
 func roundingContext(decimal *apd.Decimal, mode RoundingMode) *apd.Context {
@@ -4,6 +4,7 @@
 package currency_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -152,6 +153,75 @@ func TestNewAmountFromInt64(t *testing.T) {
 	}
 }
 
+func TestAmount_Format(t *testing.T) {
+	tests := []struct {
+		format       string
+		number       string
+		currencyCode string
+		want         string
+	}{
+		{"%v", "10.99", "USD", "10.99 USD"},
+		{"%s", "10.99", "USD", "10.99 USD"},
+		{"%q", "10.99", "USD", `"10.99 USD"`},
+		{"%f", "10.99", "USD", "10.99"},
+		{"%d", "10.99", "USD", "1099"},
+		{"%d", "10.995", "USD", "1100"},
+		{"%d", "-50", "JPY", "-50"},
+
+		// Precision rounds the number.
+		{"%.2f", "10.995", "USD", "11.00"},
+		{"%.0f", "10.5", "USD", "11"},
+		{"%.3f", "10.5", "USD", "10.500"},
+		{"%.1v", "10.25", "USD", "10.3 USD"},
+		{"%.1q", "10.25", "USD", `"10.3 USD"`},
+
+		// Flags.
+		{"%+v", "10.99", "USD", "+10.99 USD"},
+		{"%+v", "-10.99", "USD", "-10.99 USD"},
+		{"%+f", "10.99", "USD", "+10.99"},
+		{"% f", "10.99", "USD", " 10.99"},
+		{"%+d", "10.99", "USD", "+1099"},
+
+		// Width.
+		{"%12v", "10.99", "USD", "   10.99 USD"},
+		{"%-12v|", "10.99", "USD", "10.99 USD   |"},
+		{"%8f", "10.99", "USD", "   10.99"},
+		{"%-8f|", "10.99", "USD", "10.99   |"},
+		{"%08f", "-10.99", "USD", "-0010.99"},
+		{"%08.1f", "10.99", "USD", "000011.0"},
+		{"%6d", "10.99", "USD", "  1099"},
+		{"%06d", "-10.99", "USD", "-01099"},
+		// Width smaller than the value.
+		{"%2v", "10.99", "USD", "10.99 USD"},
+
+		// Fixed-point notation.
+		{"%v", "1E+3", "USD", "1000 USD"},
+		{"%f", "1E+3", "USD", "1000"},
+		{"%f", "0.0000001", "USD", "0.0000001"},
+		{"%q", "-1.5E+2", "USD", `"-150 USD"`},
+
+		// Negative zero.
+		{"%v", "-0", "USD", "0 USD"},
+		{"%f", "-0.00", "USD", "0.00"},
+		{"%.2f", "-0.001", "USD", "0.00"},
+		{"%+f", "-0", "USD", "+0"},
+		{"%05f", "-0", "USD", "00000"},
+
+		// Unsupported verb.
+		{"%x", "10.99", "USD", "%!x(currency.Amount=10.99 USD)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			a, _ := currency.NewAmount(tt.number, tt.currencyCode)
+			got := fmt.Sprintf(tt.format, a)
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestAmount_BigInt(t *testing.T) {
 	tests := []struct {
 		number       string
@@ -184,6 +254,116 @@ func TestAmount_BigInt(t *testing.T) {
 	}
 }
 
+func TestNewAmountFromUnits(t *testing.T) {
+	tests := []struct {
+		units        int64
+		nanos        int32
+		currencyCode string
+		wantNumber   string
+		wantError    string
+	}{
+		{12, 500000000, "USD", "12.50", ""},
+		{-12, -500000000, "USD", "-12.50", ""},
+		{0, -500000000, "USD", "-0.50", ""},
+		{0, 5, "USD", "5E-9", ""},
+		{12, 0, "USD", "12.00", ""},
+		{12, 0, "JPY", "12", ""},
+		{1, 123000000, "BHD", "1.123", ""},
+		{1, 120000000, "BHD", "1.120", ""},
+		{0, 0, "EUR", "0.00", ""},
+		{9223372036854775807, 999999999, "USD", "9223372036854775807.999999999", ""},
+		{-9223372036854775808, -999999999, "USD", "-9223372036854775808.999999999", ""},
+		{12, -500000000, "USD", "", "invalid units 12 and nanos -500000000: units and nanos have different signs"},
+		{-12, 500000000, "USD", "", "invalid units -12 and nanos 500000000: units and nanos have different signs"},
+		{1, 1000000000, "USD", "", "invalid units 1 and nanos 1000000000: nanos out of range"},
+		{-1, -1000000000, "USD", "", "invalid units -1 and nanos -1000000000: nanos out of range"},
+		{12, 0, "usd", "", `invalid currency code "usd"`},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			a, err := currency.NewAmountFromUnits(tt.units, tt.nanos, tt.currencyCode)
+			if tt.wantError != "" {
+				if err == nil || err.Error() != tt.wantError {
+					t.Errorf("got %v, want %v", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if a.Number() != tt.wantNumber {
+				t.Errorf("got %v, want %v", a.Number(), tt.wantNumber)
+			}
+			if a.CurrencyCode() != tt.currencyCode {
+				t.Errorf("got %v, want %v", a.CurrencyCode(), tt.currencyCode)
+			}
+		})
+	}
+}
+
+func TestAmount_Units(t *testing.T) {
+	tests := []struct {
+		number    string
+		wantUnits int64
+		wantNanos int32
+		wantError bool
+	}{
+		{"12.50", 12, 500000000, false},
+		{"-12.50", -12, -500000000, false},
+		{"-0.50", 0, -500000000, false},
+		{"0.000000005", 0, 5, false},
+		{"12", 12, 0, false},
+		{"1.2E+3", 1200, 0, false},
+		{"0", 0, 0, false},
+		{"12.5000000000000", 12, 500000000, false},
+		{"9223372036854775807.999999999", 9223372036854775807, 999999999, false},
+		{"-9223372036854775808.999999999", -9223372036854775808, -999999999, false},
+		// More than 9 fraction digits.
+		{"0.0000000001", 0, 0, true},
+		{"12.5000000001", 0, 0, true},
+		// Units overflow.
+		{"9223372036854775808", 0, 0, true},
+		{"1E+30", 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.number, func(t *testing.T) {
+			a, _ := currency.NewAmount(tt.number, "USD")
+			units, nanos, err := a.Units()
+			if tt.wantError {
+				if _, ok := err.(currency.UnitsOverflowError); !ok {
+					t.Errorf("got %T, want currency.UnitsOverflowError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if units != tt.wantUnits {
+				t.Errorf("got %v, want %v", units, tt.wantUnits)
+			}
+			if nanos != tt.wantNanos {
+				t.Errorf("got %v, want %v", nanos, tt.wantNanos)
+			}
+
+			// Confirm that the units and nanos convert back to the same amount.
+			b, err := currency.NewAmountFromUnits(units, nanos, "USD")
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !a.Equal(b) {
+				t.Errorf("got %v, want %v", b, a)
+			}
+		})
+	}
+
+	a, _ := currency.NewAmount("0.0000000001", "USD")
+	_, _, err := a.Units()
+	wantError := `amount "0.0000000001 USD" can't be represented as units and nanos`
+	if err == nil || err.Error() != wantError {
+		t.Errorf("got %v, want %v", err, wantError)
+	}
+}
+
 func TestAmount_Int64(t *testing.T) {
 	// Number that can't be represented as an int64.
 	a, _ := currency.NewAmount("922337203685477598799", "USD")
@@ -485,6 +665,111 @@ func TestAmount_Div(t *testing.T) {
 	}
 }
 
+func TestAmount_Allocate(t *testing.T) {
+	a, _ := currency.NewAmount("100", "USD")
+	_, err := a.Allocate()
+	if e, ok := err.(currency.InvalidRatiosError); ok {
+		wantError := "no ratios given"
+		if e.Error() != wantError {
+			t.Errorf("got %v, want %v", e.Error(), wantError)
+		}
+	} else {
+		t.Errorf("got %T, want currency.InvalidRatiosError", err)
+	}
+
+	_, err = a.Allocate("0", "0")
+	if e, ok := err.(currency.InvalidRatiosError); ok {
+		wantError := `invalid ratios ["0" "0"]`
+		if e.Error() != wantError {
+			t.Errorf("got %v, want %v", e.Error(), wantError)
+		}
+	} else {
+		t.Errorf("got %T, want currency.InvalidRatiosError", err)
+	}
+
+	_, err = a.Allocate("1", "-1")
+	if _, ok := err.(currency.InvalidRatiosError); !ok {
+		t.Errorf("got %T, want currency.InvalidRatiosError", err)
+	}
+
+	_, err = a.Allocate("1", "INVALID")
+	if e, ok := err.(currency.InvalidNumberError); ok {
+		if e.Number != "INVALID" {
+			t.Errorf("got %v, want INVALID", e.Number)
+		}
+	} else {
+		t.Errorf("got %T, want currency.InvalidNumberError", err)
+	}
+
+	tests := []struct {
+		number       string
+		currencyCode string
+		ratios       []string
+		want         []string
+	}{
+		{"100", "USD", []string{"1", "1", "1"}, []string{"33.34", "33.33", "33.33"}},
+		{"-100", "USD", []string{"1", "1", "1"}, []string{"-33.34", "-33.33", "-33.33"}},
+		{"0.05", "USD", []string{"70", "30"}, []string{"0.04", "0.01"}},
+		{"0.05", "USD", []string{"0.7", "0.3"}, []string{"0.04", "0.01"}},
+		{"100", "JPY", []string{"1", "1", "1"}, []string{"34", "33", "33"}},
+		{"-100", "JPY", []string{"1", "1", "1"}, []string{"-34", "-33", "-33"}},
+		{"10", "BHD", []string{"1", "2"}, []string{"3.334", "6.666"}},
+		// Zero ratios never receive the remainder.
+		{"10", "USD", []string{"0", "1", "1", "1"}, []string{"0.00", "3.34", "3.33", "3.33"}},
+		// Amounts with more digits than the currency keep their precision.
+		{"10.005", "USD", []string{"1", "1"}, []string{"5.003", "5.002"}},
+		{"0", "EUR", []string{"1", "2"}, []string{"0.00", "0.00"}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			a, _ := currency.NewAmount(tt.number, tt.currencyCode)
+			parts, err := a.Allocate(tt.ratios...)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if len(parts) != len(tt.want) {
+				t.Fatalf("got %v parts, want %v", len(parts), len(tt.want))
+			}
+			var sum currency.Amount
+			for i, part := range parts {
+				if part.Number() != tt.want[i] {
+					t.Errorf("part %v: got %v, want %v", i, part.Number(), tt.want[i])
+				}
+				if part.CurrencyCode() != tt.currencyCode {
+					t.Errorf("part %v: got %v, want %v", i, part.CurrencyCode(), tt.currencyCode)
+				}
+				sum, _ = sum.Add(part)
+			}
+			if !sum.Equal(a) {
+				t.Errorf("sum: got %v, want %v", sum, a)
+			}
+		})
+	}
+}
+
+func TestAmount_Split(t *testing.T) {
+	a, _ := currency.NewAmount("10", "EUR")
+	_, err := a.Split(0)
+	if _, ok := err.(currency.InvalidRatiosError); !ok {
+		t.Errorf("got %T, want currency.InvalidRatiosError", err)
+	}
+
+	parts, err := a.Split(3)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	want := []string{"3.34", "3.33", "3.33"}
+	if len(parts) != len(want) {
+		t.Fatalf("got %v parts, want %v", len(parts), len(want))
+	}
+	for i, part := range parts {
+		if part.Number() != want[i] {
+			t.Errorf("part %v: got %v, want %v", i, part.Number(), want[i])
+		}
+	}
+}
+
 func TestAmount_Round(t *testing.T) {
 	tests := []struct {
 		number       string
@@ -587,6 +872,100 @@ func TestAmount_RoundTo(t *testing.T) {
 	}
 }
 
+func TestAmount_RoundCash(t *testing.T) {
+	tests := []struct {
+		number       string
+		currencyCode string
+		want         string
+	}{
+		{"12.32", "CHF", "12.30"},
+		{"12.325", "CHF", "12.35"},
+		{"12.38", "CHF", "12.40"},
+		{"-12.38", "CHF", "-12.40"},
+		{"12.24", "DKK", "12.00"},
+		{"12.25", "DKK", "12.50"},
+		{"12.49", "SEK", "12"},
+		{"12.50", "SEK", "13"},
+		{"12.345", "EUR", "12.35"},
+		{"12.5", "JPY", "13"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			a, _ := currency.NewAmount(tt.number, tt.currencyCode)
+			b := a.RoundCash()
+			if b.Number() != tt.want {
+				t.Errorf("got %v, want %v", b.Number(), tt.want)
+			}
+			// Confirm that a is unchanged.
+			if a.Number() != tt.number {
+				t.Errorf("got %v, want %v", a.Number(), tt.number)
+			}
+		})
+	}
+}
+
+func TestAmount_RoundToIncrement(t *testing.T) {
+	a, _ := currency.NewAmount("12.34", "USD")
+	for _, increment := range []string{"INVALID", "0", "-0.05"} {
+		_, err := a.RoundToIncrement(increment, currency.RoundHalfUp)
+		if e, ok := err.(currency.InvalidNumberError); ok {
+			if e.Number != increment {
+				t.Errorf("got %v, want %v", e.Number, increment)
+			}
+		} else {
+			t.Errorf("got %T, want currency.InvalidNumberError", err)
+		}
+	}
+
+	tests := []struct {
+		number    string
+		increment string
+		mode      currency.RoundingMode
+		want      string
+	}{
+		{"12.325", "0.05", currency.RoundHalfUp, "12.35"},
+		{"12.325", "0.05", currency.RoundHalfDown, "12.30"},
+		{"12.31", "0.05", currency.RoundUp, "12.35"},
+		{"12.34", "0.05", currency.RoundDown, "12.30"},
+		{"12.325", "0.05", currency.RoundHalfEven, "12.30"},
+		{"12.375", "0.05", currency.RoundHalfEven, "12.40"},
+		{"-12.325", "0.05", currency.RoundHalfUp, "-12.35"},
+		{"-12.31", "0.05", currency.RoundUp, "-12.35"},
+		{"-12.34", "0.05", currency.RoundDown, "-12.30"},
+		{"12.75", "0.50", currency.RoundHalfUp, "13.00"},
+		{"1234", "100", currency.RoundHalfUp, "1200"},
+		{"12.34", "5E+1", currency.RoundHalfUp, "0"},
+		{"1234.56", "5E+1", currency.RoundHalfUp, "1250"},
+		{"1234.56", "1E+2", currency.RoundDown, "1200"},
+		{"12.30", "0.05", currency.RoundUp, "12.30"},
+		{"12", "0.05", currency.RoundHalfUp, "12.00"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			a, _ := currency.NewAmount(tt.number, "USD")
+			b, err := a.RoundToIncrement(tt.increment, tt.mode)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if b.Number() != tt.want {
+				t.Errorf("got %v, want %v", b.Number(), tt.want)
+			}
+			if b.CurrencyCode() != "USD" {
+				t.Errorf("got %v, want USD", b.CurrencyCode())
+			}
+		})
+	}
+
+	// Whole number increments are formatted without an exponent.
+	b, _ := a.RoundToIncrement("5E+1", currency.RoundHalfUp)
+	formatter := currency.NewFormatter(currency.NewLocale("en"))
+	if got := formatter.Format(b); got != "$0.00" {
+		t.Errorf("got %v, want $0.00", got)
+	}
+}
+
 func TestAmount_RoundToWithConcurrency(t *testing.T) {
 	n := 2
 	roundingModes := []currency.RoundingMode{
@@ -725,10 +1104,87 @@ func TestAmount_MarshalBinary(t *testing.T) {
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
 	}
-	got := string(d)
-	want := "USD3.45"
-	if got != want {
-		t.Errorf("got %v, want %v", got, want)
+	want := []byte{0x01, 0xc8, 0x06, 0x03, 0xe4, 0x0a}
+	if !bytes.Equal(d, want) {
+		t.Errorf("got %x, want %x", d, want)
+	}
+}
+
+func TestAmount_BinaryRoundTrip(t *testing.T) {
+	tests := []struct {
+		number       string
+		currencyCode string
+	}{
+		{"3.45", "USD"},
+		{"-3.45", "USD"},
+		{"0", "EUR"},
+		{"-0.00", "EUR"},
+		{"1000", "JPY"},
+		{"1.2E+5", "JPY"},
+		{"0.000000001", "BHD"},
+		{"4611686018427387903", "USD"},
+		{"4611686018427387904", "USD"},
+		{"-123456789012345678901234567890.123456789", "USD"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.number, func(t *testing.T) {
+			a, _ := currency.NewAmount(tt.number, tt.currencyCode)
+			d, err := a.MarshalBinary()
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			got := &currency.Amount{}
+			err = got.UnmarshalBinary(d)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if got.Number() != a.Number() {
+				t.Errorf("got %v, want %v", got.Number(), a.Number())
+			}
+			if got.CurrencyCode() != a.CurrencyCode() {
+				t.Errorf("got %v, want %v", got.CurrencyCode(), a.CurrencyCode())
+			}
+		})
+	}
+
+	// The zero value.
+	d, _ := currency.Amount{}.MarshalBinary()
+	got, _ := currency.NewAmount("1", "USD")
+	err := got.UnmarshalBinary(d)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if got != (currency.Amount{}) {
+		t.Errorf("got %v, want the zero value", got)
+	}
+}
+
+func TestAmount_UnmarshalBinaryErrors(t *testing.T) {
+	tests := []struct {
+		data      []byte
+		wantError string
+	}{
+		{[]byte{0x02, 0xc8, 0x06, 0x03, 0xe4, 0x0a}, "invalid binary data: unsupported version 2"},
+		{[]byte{0x01}, "invalid binary data: invalid numeric code"},
+		{[]byte{0x01, 0xc8, 0x06}, "invalid binary data: invalid exponent"},
+		{[]byte{0x01, 0xc8, 0x06, 0x03}, "invalid binary data: invalid coefficient"},
+		{[]byte{0x01, 0xc8, 0x06, 0x03, 0xe4}, "invalid binary data: invalid coefficient"},
+		{[]byte{0x01, 0xc8, 0x06, 0x03, 0x0a, 0x01}, "invalid binary data: truncated coefficient"},
+		{[]byte{0x01, 0xc8, 0x06, 0x03, 0xe4, 0x0a, 0x00}, "invalid binary data: 1 unexpected trailing bytes"},
+		{[]byte{0x01, 0x01, 0x03, 0xe4, 0x0a}, `invalid currency code "001"`},
+		{[]byte{0x01, 0x00, 0x03, 0xe4, 0x0a}, `invalid currency code ""`},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			a := &currency.Amount{}
+			err := a.UnmarshalBinary(tt.data)
+			if err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if err.Error() != tt.wantError {
+				t.Errorf("got %v, want %v", err.Error(), tt.wantError)
+			}
+		})
 	}
 }
 
@@ -789,6 +1245,114 @@ func TestAmount_UnmarshalBinary(t *testing.T) {
 	}
 }
 
+func TestAmount_MarshalText(t *testing.T) {
+	tests := []struct {
+		number       string
+		currencyCode string
+		want         string
+	}{
+		{"12.50", "EUR", "12.50 EUR"},
+		{"-3.45", "USD", "-3.45 USD"},
+		{"1.2E+5", "JPY", "120000 JPY"},
+		{"0.0000001", "USD", "0.0000001 USD"},
+		{"", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			a, _ := currency.NewAmount(tt.number, tt.currencyCode)
+			d, err := a.MarshalText()
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if string(d) != tt.want {
+				t.Errorf("got %q, want %q", d, tt.want)
+			}
+			if tt.want != "" && tt.want != a.String() {
+				t.Errorf("got %q, want %q", d, a.String())
+			}
+			var b currency.Amount
+			if err := b.UnmarshalText(d); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !b.Equal(a) {
+				t.Errorf("got %v, want %v", b, a)
+			}
+		})
+	}
+}
+
+func TestAmount_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		text             string
+		wantNumber       string
+		wantCurrencyCode string
+		wantError        string
+	}{
+		{"12.50 EUR", "12.50", "EUR", ""},
+		{"-12.50 EUR", "-12.50", "EUR", ""},
+		{"  12.50   EUR ", "12.50", "EUR", ""},
+		{"12.50EUR", "12.50", "EUR", ""},
+		{"12.50 eur", "12.50", "EUR", ""},
+		{"EUR 12.50", "12.50", "EUR", ""},
+		{"EUR12.50", "12.50", "EUR", ""},
+		{"EUR -12.50", "-12.50", "EUR", ""},
+		{"-EUR12.50", "-12.50", "EUR", ""},
+		{"+EUR 12.50", "12.50", "EUR", ""},
+		{"1.2E+5 JPY", "1.2E+5", "JPY", ""},
+		{"", "0", "", ""},
+		{" ", "0", "", ""},
+		{"12.50", "", "", `invalid currency code ""`},
+		{"12.50 EURO", "", "", `invalid currency code "EURO"`},
+		{"12.50 XXX", "", "", `invalid currency code "XXX"`},
+		{"12,50 EUR", "", "", `invalid number "12,50"`},
+		{"EUR", "", "", `invalid number ""`},
+		{"12.50 EUR USD", "", "", `invalid number "12.50 EUR"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			a, _ := currency.NewAmount("1", "USD")
+			err := a.UnmarshalText([]byte(tt.text))
+			if tt.wantError != "" {
+				if err == nil || err.Error() != tt.wantError {
+					t.Errorf("got %v, want %v", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if a.Number() != tt.wantNumber {
+				t.Errorf("got %v, want %v", a.Number(), tt.wantNumber)
+			}
+			if a.CurrencyCode() != tt.wantCurrencyCode {
+				t.Errorf("got %v, want %v", a.CurrencyCode(), tt.wantCurrencyCode)
+			}
+		})
+	}
+}
+
+func TestAmount_TextMapKey(t *testing.T) {
+	x, _ := currency.NewAmount("12.50", "EUR")
+	y, _ := currency.NewAmount("3.45", "USD")
+	d, err := json.Marshal(map[currency.Amount]string{x: "x", y: "y"})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	want := `{"12.50 EUR":"x","3.45 USD":"y"}`
+	if string(d) != want {
+		t.Errorf("got %v, want %v", string(d), want)
+	}
+
+	var got map[currency.Amount]string
+	err = json.Unmarshal(d, &got)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if got[x] != "x" || got[y] != "y" {
+		t.Errorf("got %v, want %v", got, map[currency.Amount]string{x: "x", y: "y"})
+	}
+}
+
 func TestAmount_MarshalJSON(t *testing.T) {
 	a, _ := currency.NewAmount("3.45", "USD")
 	d, err := json.Marshal(a)
@@ -908,6 +1472,24 @@ func TestAmount_Scan(t *testing.T) {
 		{"(,USD)", "0", "", `invalid number ""`},
 		{"(0,)", "0", "", ""},
 		{"(0,   )", "0", "", ""},
+		{`("3.45","USD")`, "3.45", "USD", ""},
+		{`( 3.45 , USD )`, "3.45", "USD", ""},
+		{`("3.45",USD)`, "3.45", "USD", ""},
+		{`(\3.45,US\D)`, "3.45", "USD", ""},
+		{`("3.45","US""D")`, "0", "", `invalid currency code "US\"D"`},
+		{`("3.45","US\"D")`, "0", "", `invalid currency code "US\"D"`},
+		{`("3,45",USD)`, "0", "", `invalid number "3,45"`},
+		{"(3.45,USD,EUR)", "0", "", `invalid composite value "(3.45,USD,EUR)": got 3 fields, want 2`},
+		{"(3.45)", "0", "", `invalid composite value "(3.45)": got 1 fields, want 2`},
+		{`("3.45,USD)`, "0", "", `invalid composite value "(\"3.45,USD)"`},
+		{"(3.45,USD", "0", "", `invalid composite value "(3.45,USD"`},
+		{"3.45,USD", "3.45", "USD", ""},
+		{"3.45,", "0", "", `invalid currency code ""`},
+		{"0,", "0", "", ""},
+		{"3.45,USD,EUR", "0", "", `invalid composite value "(3.45,USD,EUR)": got 3 fields, want 2`},
+		{"3.45 USD", "3.45", "USD", ""},
+		{"USD3.45", "3.45", "USD", ""},
+		{"3.45", "0", "", `invalid currency code ""`},
 	}
 
 	for _, tt := range tests {
@@ -931,6 +1513,84 @@ func TestAmount_Scan(t *testing.T) {
 	}
 }
 
+func TestAmount_ScanBytes(t *testing.T) {
+	var a currency.Amount
+	err := a.Scan([]byte("(3.45,USD)"))
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if a.Number() != "3.45" {
+		t.Errorf("got %v, want 3.45", a.Number())
+	}
+	if a.CurrencyCode() != "USD" {
+		t.Errorf("got %v, want USD", a.CurrencyCode())
+	}
+}
+
+func TestAmount_ScanNil(t *testing.T) {
+	a, _ := currency.NewAmount("3.45", "USD")
+	err := a.Scan(nil)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if a != (currency.Amount{}) {
+		t.Errorf("got %v, want the zero value", a)
+	}
+}
+
+func TestNullAmount_Scan(t *testing.T) {
+	tests := []struct {
+		src        interface{}
+		wantNumber string
+		wantValid  bool
+		wantError  string
+	}{
+		{nil, "0", false, ""},
+		{"(3.45,USD)", "3.45", true, ""},
+		{[]byte("(3.45,USD)"), "3.45", true, ""},
+		{"(3.45,)", "0", false, `invalid currency code ""`},
+		{"3.45", "0", false, `invalid currency code ""`},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			// Start from a valid amount, to confirm that it's reset on errors.
+			a, _ := currency.NewAmount("9.99", "EUR")
+			n := currency.NullAmount{Amount: a, Valid: true}
+			err := n.Scan(tt.src)
+			if n.Amount.Number() != tt.wantNumber {
+				t.Errorf("number: got %v, want %v", n.Amount.Number(), tt.wantNumber)
+			}
+			if n.Valid != tt.wantValid {
+				t.Errorf("valid: got %v, want %v", n.Valid, tt.wantValid)
+			}
+			errStr := ""
+			if err != nil {
+				errStr = err.Error()
+			}
+			if errStr != tt.wantError {
+				t.Errorf("error: got %v, want %v", errStr, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestNullAmount_Value(t *testing.T) {
+	got, err := currency.NullAmount{}.Value()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if got != nil {
+		t.Errorf("got %v, want nil", got)
+	}
+
+	a, _ := currency.NewAmount("3.45", "USD")
+	got, _ = currency.NullAmount{Amount: a, Valid: true}.Value()
+	want := "(3.45,USD)"
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
 func TestAmount_ScanNonString(t *testing.T) {
 	var a currency.Amount
 	err := a.Scan(123)
@@ -0,0 +1,336 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Bag holds amounts in multiple currencies, one amount per currency code.
+//
+// Bags have value semantics, like amounts: operations return a new Bag,
+// leaving the original unchanged. The zero value is an empty bag.
+type Bag struct {
+	amounts map[string]Amount
+}
+
+// NewBag creates a new Bag containing the sum of the given amounts.
+func NewBag(amounts ...Amount) Bag {
+	return Bag{}.Add(amounts...)
+}
+
+// Add adds the given amounts to b and returns the result.
+//
+// Zero value amounts (with no currency code) are ignored.
+func (b Bag) Add(amounts ...Amount) Bag {
+	result := b.clone()
+	for _, a := range amounts {
+		if a.currencyCode == "" {
+			continue
+		}
+		// Adding amounts with the same currency code can't fail.
+		result.amounts[a.currencyCode], _ = result.amounts[a.currencyCode].Add(a)
+	}
+
+	return result
+}
+
+// Sub subtracts the given amounts from b and returns the result.
+//
+// Zero value amounts (with no currency code) are ignored.
+func (b Bag) Sub(amounts ...Amount) Bag {
+	result := b.clone()
+	for _, a := range amounts {
+		if a.currencyCode == "" {
+			continue
+		}
+		result.amounts[a.currencyCode], _ = result.amounts[a.currencyCode].Sub(a)
+	}
+
+	return result
+}
+
+// Negate negates all amounts in b and returns the result.
+func (b Bag) Negate() Bag {
+	result := b.clone()
+	for currencyCode, a := range result.amounts {
+		// Avoid producing a negative zero.
+		if !a.IsZero() {
+			result.amounts[currencyCode], _ = a.Mul("-1")
+		}
+	}
+
+	return result
+}
+
+// Amount returns the amount for the given currency code.
+//
+// Returns false if b doesn't contain an amount in that currency.
+func (b Bag) Amount(currencyCode string) (Amount, bool) {
+	a, ok := b.amounts[currencyCode]
+	return a, ok
+}
+
+// Amounts returns all amounts in b, ordered as in GetCurrencyCodes().
+func (b Bag) Amounts() []Amount {
+	if len(b.amounts) == 0 {
+		return nil
+	}
+	amounts := make([]Amount, 0, len(b.amounts))
+	for _, currencyCode := range currencyCodes {
+		if a, ok := b.amounts[currencyCode]; ok {
+			amounts = append(amounts, a)
+		}
+	}
+
+	return amounts
+}
+
+// CurrencyCodes returns the currency codes of all amounts in b, ordered as in GetCurrencyCodes().
+func (b Bag) CurrencyCodes() []string {
+	amounts := b.Amounts()
+	currencyCodes := make([]string, len(amounts))
+	for i, a := range amounts {
+		currencyCodes[i] = a.currencyCode
+	}
+
+	return currencyCodes
+}
+
+// Len returns the number of amounts in b.
+func (b Bag) Len() int {
+	return len(b.amounts)
+}
+
+// IsZero returns whether all amounts in b are zero.
+//
+// An empty bag is considered zero.
+func (b Bag) IsZero() bool {
+	for _, a := range b.amounts {
+		if !a.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns whether b and other contain equal amounts.
+//
+// Zero amounts are ignored, so a bag containing "0 USD" is equal to an empty bag.
+func (b Bag) Equal(other Bag) bool {
+	for currencyCode, a := range b.amounts {
+		otherAmount, ok := other.amounts[currencyCode]
+		if !ok {
+			otherAmount = Amount{currencyCode: currencyCode}
+		}
+		if a.number.Cmp(&otherAmount.number) != 0 {
+			return false
+		}
+	}
+	for currencyCode, a := range other.amounts {
+		if _, ok := b.amounts[currencyCode]; !ok && !a.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// Collapse converts all amounts in b to the given currency and returns their sum.
+//
+// The rate function returns the exchange rate between two currencies,
+// as a numeric string. It is not called for amounts already in the
+// given currency. A Converter's Rate method can be used via a closure.
+func (b Bag) Collapse(currencyCode string, rate func(from, to string) (string, error)) (Amount, error) {
+	if currencyCode == "" || !IsValid(currencyCode) {
+		return Amount{}, InvalidCurrencyCodeError{currencyCode}
+	}
+	sum := Amount{currencyCode: currencyCode}
+	for _, a := range b.Amounts() {
+		if a.currencyCode != currencyCode {
+			r, err := rate(a.currencyCode, currencyCode)
+			if err != nil {
+				return Amount{}, err
+			}
+			a, err = a.Convert(currencyCode, r)
+			if err != nil {
+				return Amount{}, err
+			}
+		}
+		sum, _ = sum.Add(a)
+	}
+
+	return sum, nil
+}
+
+// String returns the string representation of b.
+func (b Bag) String() string {
+	amounts := b.Amounts()
+	parts := make([]string, len(amounts))
+	for i, a := range amounts {
+		parts[i] = a.String()
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+//
+// The bag is marshaled as an array of amounts.
+func (b Bag) MarshalJSON() ([]byte, error) {
+	amounts := b.Amounts()
+	if amounts == nil {
+		amounts = []Amount{}
+	}
+	return json.Marshal(amounts)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (b *Bag) UnmarshalJSON(data []byte) error {
+	var amounts []Amount
+	if err := json.Unmarshal(data, &amounts); err != nil {
+		return err
+	}
+	*b = NewBag(amounts...)
+
+	return nil
+}
+
+// Value implements the database/driver.Valuer interface.
+//
+// Allows storing bags in a PostgreSQL array of composite types.
+func (b Bag) Value() (driver.Value, error) {
+	amounts := b.Amounts()
+	elements := make([]string, len(amounts))
+	for i, a := range amounts {
+		v, _ := a.Value()
+		elements[i] = quoteArrayElement(v.(string))
+	}
+
+	return "{" + strings.Join(elements, ",") + "}", nil
+}
+
+// Scan implements the database/sql.Scanner interface.
+//
+// Allows scanning bags from a PostgreSQL array of composite types,
+// as returned by drivers either as a string or a []byte.
+// NULL is scanned as an empty bag.
+func (b *Bag) Scan(src interface{}) error {
+	// Wire format: {"(9.99,USD)","(5,JPY)"}.
+	var input string
+	switch src := src.(type) {
+	case string:
+		input = src
+	case []byte:
+		input = string(src)
+	case nil:
+		*b = Bag{}
+		return nil
+	default:
+		return fmt.Errorf("value is not a string: %v", src)
+	}
+	input = strings.TrimSpace(input)
+	if input == "" || input == "{}" {
+		*b = Bag{}
+		return nil
+	}
+	if !strings.HasPrefix(input, "{") || !strings.HasSuffix(input, "}") {
+		return fmt.Errorf("value is not an array: %v", input)
+	}
+	elements, err := parseArray(input)
+	if err != nil {
+		return err
+	}
+	amounts := make([]Amount, len(elements))
+	for i, element := range elements {
+		if err := amounts[i].Scan(element); err != nil {
+			return err
+		}
+	}
+	*b = NewBag(amounts...)
+
+	return nil
+}
+
+// clone returns a copy of b, with an initialized map.
+func (b Bag) clone() Bag {
+	amounts := make(map[string]Amount, len(b.amounts)+1)
+	for currencyCode, a := range b.amounts {
+		amounts[currencyCode] = a
+	}
+
+	return Bag{amounts}
+}
+
+// quoteArrayElement quotes an element of a PostgreSQL array literal.
+//
+// Double quotes and backslashes are escaped with a backslash.
+func quoteArrayElement(s string) string {
+	return `"` + arrayElementEscaper.Replace(s) + `"`
+}
+
+var arrayElementEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// parseArray parses the elements of a one-dimensional PostgreSQL array literal.
+//
+// Elements are separated by commas, and can be double quoted. A backslash
+// escapes the next character, both inside and outside of quotes. Unquoted
+// whitespace around elements is removed. NULL elements are not allowed.
+func parseArray(s string) ([]string, error) {
+	var elements []string
+	element := strings.Builder{}
+	quoted, escaped, inQuotes := false, false, false
+	for i := 1; i < len(s)-1; i++ {
+		c := s[i]
+		switch {
+		case escaped:
+			element.WriteByte(c)
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '"':
+			inQuotes = !inQuotes
+			quoted = true
+		case inQuotes:
+			element.WriteByte(c)
+		case c == ' ' && (quoted || element.Len() == 0):
+			// Whitespace around quoted or leading an element.
+		case c == ',':
+			value, err := arrayElement(s, element.String(), quoted)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, value)
+			element.Reset()
+			quoted = false
+		default:
+			element.WriteByte(c)
+		}
+	}
+	if inQuotes || escaped {
+		return nil, fmt.Errorf("invalid array value %q", s)
+	}
+	value, err := arrayElement(s, element.String(), quoted)
+	if err != nil {
+		return nil, err
+	}
+	elements = append(elements, value)
+
+	return elements, nil
+}
+
+// arrayElement returns the value of an element of the array literal s.
+func arrayElement(s string, element string, quoted bool) (string, error) {
+	if quoted {
+		return element, nil
+	}
+	element = strings.TrimSpace(element)
+	if strings.EqualFold(element, "NULL") {
+		return "", fmt.Errorf("invalid array value %q: NULL elements are not supported", s)
+	}
+
+	return element, nil
+}
@@ -0,0 +1,286 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/plenigo/currency"
+)
+
+func TestBag_Add(t *testing.T) {
+	usd, _ := currency.NewAmount("20.99", "USD")
+	eur, _ := currency.NewAmount("3.50", "EUR")
+	jpy, _ := currency.NewAmount("500", "JPY")
+	var z currency.Amount
+
+	b := currency.NewBag(usd, eur)
+	c := b.Add(usd, jpy, z)
+	if c.String() != "3.50 EUR, 500 JPY, 41.98 USD" {
+		t.Errorf("got %v, want 3.50 EUR, 500 JPY, 41.98 USD", c.String())
+	}
+	if c.Len() != 3 {
+		t.Errorf("got %v, want 3", c.Len())
+	}
+	// Confirm that b is unchanged.
+	if b.String() != "3.50 EUR, 20.99 USD" {
+		t.Errorf("got %v, want 3.50 EUR, 20.99 USD", b.String())
+	}
+
+	var empty currency.Bag
+	d := empty.Add(usd)
+	if d.String() != "20.99 USD" {
+		t.Errorf("got %v, want 20.99 USD", d.String())
+	}
+	if empty.Len() != 0 {
+		t.Errorf("got %v, want 0", empty.Len())
+	}
+}
+
+func TestBag_Sub(t *testing.T) {
+	usd, _ := currency.NewAmount("20.99", "USD")
+	eur, _ := currency.NewAmount("3.50", "EUR")
+
+	b := currency.NewBag(usd)
+	c := b.Sub(eur, usd)
+	if c.String() != "-3.50 EUR, 0.00 USD" {
+		t.Errorf("got %v, want -3.50 EUR, 0.00 USD", c.String())
+	}
+	// Confirm that b is unchanged.
+	if b.String() != "20.99 USD" {
+		t.Errorf("got %v, want 20.99 USD", b.String())
+	}
+
+	d := c.Negate()
+	if d.String() != "3.50 EUR, 0.00 USD" {
+		t.Errorf("got %v, want 3.50 EUR, 0.00 USD", d.String())
+	}
+}
+
+func TestBag_Amount(t *testing.T) {
+	usd, _ := currency.NewAmount("20.99", "USD")
+	b := currency.NewBag(usd)
+
+	a, ok := b.Amount("USD")
+	if !ok {
+		t.Errorf("got %v, want true", ok)
+	}
+	if !a.Equal(usd) {
+		t.Errorf("got %v, want %v", a, usd)
+	}
+	_, ok = b.Amount("EUR")
+	if ok {
+		t.Errorf("got %v, want false", ok)
+	}
+}
+
+func TestBag_Amounts(t *testing.T) {
+	var amounts []currency.Amount
+	for _, currencyCode := range []string{"RSD", "AED", "USD", "AUD", "EUR"} {
+		a, _ := currency.NewAmount("1", currencyCode)
+		amounts = append(amounts, a)
+	}
+	b := currency.NewBag(amounts...)
+
+	// G10 currencies first, then others, as in GetCurrencyCodes().
+	want := []string{"AUD", "EUR", "USD", "AED", "RSD"}
+	got := b.CurrencyCodes()
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i, a := range b.Amounts() {
+		if got[i] != want[i] || a.CurrencyCode() != want[i] {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestBag_Checks(t *testing.T) {
+	usd, _ := currency.NewAmount("20.99", "USD")
+	usdZero, _ := currency.NewAmount("0", "USD")
+	eur, _ := currency.NewAmount("3.50", "EUR")
+	eur2, _ := currency.NewAmount("3.5000", "EUR")
+
+	tests := []struct {
+		a         currency.Bag
+		b         currency.Bag
+		wantZero  bool
+		wantEqual bool
+	}{
+		{currency.Bag{}, currency.Bag{}, true, true},
+		{currency.NewBag(usdZero), currency.Bag{}, true, true},
+		{currency.Bag{}, currency.NewBag(usdZero), true, true},
+		{currency.NewBag(usd), currency.Bag{}, false, false},
+		{currency.NewBag(usd, eur), currency.NewBag(eur2, usd), false, true},
+		{currency.NewBag(usd, eur), currency.NewBag(eur), false, false},
+		{currency.NewBag(eur), currency.NewBag(usd, eur), false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if got := tt.a.IsZero(); got != tt.wantZero {
+				t.Errorf("zero: got %v, want %v", got, tt.wantZero)
+			}
+			if got := tt.a.Equal(tt.b); got != tt.wantEqual {
+				t.Errorf("equal: got %v, want %v", got, tt.wantEqual)
+			}
+		})
+	}
+}
+
+func TestBag_Collapse(t *testing.T) {
+	usd, _ := currency.NewAmount("20.99", "USD")
+	eur, _ := currency.NewAmount("3.50", "EUR")
+	jpy, _ := currency.NewAmount("500", "JPY")
+	b := currency.NewBag(usd, eur, jpy)
+	rates := map[string]string{
+		"USDEUR": "0.92",
+		"JPYEUR": "0.0059",
+	}
+	rate := func(from, to string) (string, error) {
+		if r, ok := rates[from+to]; ok {
+			return r, nil
+		}
+		return "", currency.RateNotFoundError{From: from, To: to}
+	}
+
+	_, err := b.Collapse("eur", rate)
+	if e, ok := err.(currency.InvalidCurrencyCodeError); ok {
+		if e.CurrencyCode != "eur" {
+			t.Errorf("got %v, want eur", e.CurrencyCode)
+		}
+	} else {
+		t.Errorf("got %T, want currency.InvalidCurrencyCodeError", err)
+	}
+
+	got, err := b.Collapse("EUR", rate)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if got.String() != "25.7608 EUR" {
+		t.Errorf("got %v, want 25.7608 EUR", got)
+	}
+
+	_, err = b.Collapse("GBP", rate)
+	var e currency.RateNotFoundError
+	if !errors.As(err, &e) {
+		t.Errorf("got %T, want currency.RateNotFoundError", err)
+	}
+
+	got, err = currency.Bag{}.Collapse("GBP", rate)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !got.IsZero() || got.CurrencyCode() != "GBP" {
+		t.Errorf("got %v, want 0 GBP", got)
+	}
+}
+
+func TestBag_MarshalJSON(t *testing.T) {
+	usd, _ := currency.NewAmount("20.99", "USD")
+	eur, _ := currency.NewAmount("3.50", "EUR")
+	d, err := json.Marshal(currency.NewBag(usd, eur))
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	got := string(d)
+	want := `[{"number":"3.50","currency":"EUR"},{"number":"20.99","currency":"USD"}]`
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	d, _ = json.Marshal(currency.Bag{})
+	if string(d) != "[]" {
+		t.Errorf("got %v, want []", string(d))
+	}
+}
+
+func TestBag_UnmarshalJSON(t *testing.T) {
+	d := []byte(`[{"number":"3.50","currency":"EUR"},{"number":"20.99","currency":"USD"},{"number":"1.50","currency":"EUR"}]`)
+	b := &currency.Bag{}
+	err := json.Unmarshal(d, b)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if b.String() != "5.00 EUR, 20.99 USD" {
+		t.Errorf("got %v, want 5.00 EUR, 20.99 USD", b.String())
+	}
+
+	d = []byte(`[{"number":"3.50","currency":"eur"}]`)
+	err = json.Unmarshal(d, b)
+	if _, ok := err.(currency.InvalidCurrencyCodeError); !ok {
+		t.Errorf("got %T, want currency.InvalidCurrencyCodeError", err)
+	}
+}
+
+func TestBag_Value(t *testing.T) {
+	usd, _ := currency.NewAmount("20.99", "USD")
+	eur, _ := currency.NewAmount("3.50", "EUR")
+	got, _ := currency.NewBag(usd, eur).Value()
+	want := `{"(3.50,EUR)","(20.99,USD)"}`
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	got, _ = currency.Bag{}.Value()
+	if got != "{}" {
+		t.Errorf("got %v, want {}", got)
+	}
+
+	// Confirm that the value can be scanned back.
+	jpy, _ := currency.NewAmount("-5", "JPY")
+	bag := currency.NewBag(usd, eur, jpy)
+	got, _ = bag.Value()
+	var scanned currency.Bag
+	if err := scanned.Scan(got); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !scanned.Equal(bag) {
+		t.Errorf("got %v, want %v", scanned, bag)
+	}
+}
+
+func TestBag_Scan(t *testing.T) {
+	tests := []struct {
+		src       interface{}
+		want      string
+		wantError string
+	}{
+		{"", "", ""},
+		{"{}", "", ""},
+		{`{"(3.50,EUR)","(20.99,USD)"}`, "3.50 EUR, 20.99 USD", ""},
+		{`{"(3.50,EUR)","(1.50,EUR)"}`, "5.00 EUR", ""},
+		{`{"(3.50,eur)"}`, "", `invalid currency code "eur"`},
+		{`(3.50,EUR)`, "", `value is not an array: (3.50,EUR)`},
+		{123, "", "value is not a string: 123"},
+		{nil, "", ""},
+		{[]byte(`{"(3.50,EUR)","(20.99,USD)"}`), "3.50 EUR, 20.99 USD", ""},
+		// Quoting and escaping.
+		{`{ "(3.50,EUR)" , "(20.99,USD)" }`, "3.50 EUR, 20.99 USD", ""},
+		{`{"(\"3.50\",EUR)"}`, "3.50 EUR", ""},
+		{`{"(3.50,EUR)",\(20.99\,USD\)}`, "3.50 EUR, 20.99 USD", ""},
+		{`{"(3.50,EUR)","(20.99,USD)}`, "", `invalid array value "{\"(3.50,EUR)\",\"(20.99,USD)}"`},
+		{`{"(3.50,EUR)",NULL}`, "", `invalid array value "{\"(3.50,EUR)\",NULL}": NULL elements are not supported`},
+		{`{"(3.50,EUR)","NULL"}`, "", `invalid number ""`},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			var b currency.Bag
+			err := b.Scan(tt.src)
+			if b.String() != tt.want {
+				t.Errorf("got %v, want %v", b.String(), tt.want)
+			}
+			errStr := ""
+			if err != nil {
+				errStr = err.Error()
+			}
+			if errStr != tt.wantError {
+				t.Errorf("error: got %v, want %v", errStr, tt.wantError)
+			}
+		})
+	}
+}
@@ -5,7 +5,7 @@ import (
 	"math/big"
 	"testing"
 
-	"github.com/bojanz/currency"
+	"github.com/plenigo/currency"
 )
 
 var result currency.Amount
@@ -139,3 +139,50 @@ func BenchmarkAmount_Cmp(b *testing.B) {
 	}
 	cmpResult = z
 }
+
+var formatResult string
+var appendResult []byte
+
+func BenchmarkFormatter_Format(b *testing.B) {
+	x, _ := currency.NewAmount("-1234.59", "EUR")
+	for _, localeID := range []string{"en", "de-CH", "fr", "ar", "hi"} {
+		b.Run(localeID, func(b *testing.B) {
+			formatter := currency.NewFormatter(currency.NewLocale(localeID))
+			b.ReportAllocs()
+
+			var z string
+			for n := 0; n < b.N; n++ {
+				z = formatter.Format(x)
+			}
+			formatResult = z
+		})
+	}
+}
+
+func BenchmarkFormatter_AppendFormat(b *testing.B) {
+	x, _ := currency.NewAmount("-1234.59", "EUR")
+	for _, localeID := range []string{"en", "de-CH", "fr", "ar", "hi"} {
+		b.Run(localeID, func(b *testing.B) {
+			formatter := currency.NewFormatter(currency.NewLocale(localeID))
+			b.ReportAllocs()
+
+			buf := make([]byte, 0, 64)
+			for n := 0; n < b.N; n++ {
+				buf = formatter.AppendFormat(buf[:0], x)
+			}
+			appendResult = buf
+		})
+	}
+}
+
+func BenchmarkFormatter_FormatToParts(b *testing.B) {
+	x, _ := currency.NewAmount("-1234.59", "EUR")
+	formatter := currency.NewFormatter(currency.NewLocale("en"))
+	b.ReportAllocs()
+
+	var z string
+	for n := 0; n < b.N; n++ {
+		z = formatter.FormatToParts(x)[0].Value
+	}
+	formatResult = z
+}
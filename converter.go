@@ -0,0 +1,226 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/apd/v3"
+)
+
+// RateNotFoundError is returned when no exchange rate is available for a currency pair.
+type RateNotFoundError struct {
+	From string
+	To   string
+}
+
+func (e RateNotFoundError) Error() string {
+	return fmt.Sprintf("no exchange rate from %q to %q", e.From, e.To)
+}
+
+// RateProvider provides exchange rates.
+type RateProvider interface {
+	// Rate returns the exchange rate between two currencies, as a numeric string.
+	//
+	// The at parameter specifies the time for which the rate is requested,
+	// allowing providers to return historical rates.
+	// Returns a RateNotFoundError if the rate is not available.
+	Rate(from, to string, at time.Time) (string, error)
+}
+
+// Converter converts amounts between currencies using a RateProvider.
+type Converter struct {
+	provider RateProvider
+	// BaseCurrency specifies the currency used for cross-rate triangulation.
+	// For example, if the base currency is "EUR", the USD->JPY rate can be
+	// calculated from the USD->EUR and EUR->JPY rates.
+	// Defaults to "" (no triangulation).
+	BaseCurrency string
+	// NoInverseRates turns off the fallback to inverse rates.
+	// By default, a missing USD->EUR rate is calculated as 1 / (EUR->USD rate).
+	// When enabled, a RateNotFoundError is returned instead.
+	// Defaults to false.
+	NoInverseRates bool
+	// NoRounding turns off rounding of converted amounts.
+	// Defaults to false.
+	NoRounding bool
+	// Digits specifies the number of fraction digits of converted amounts.
+	// Defaults to currency.DefaultDigits (e.g. 2 for USD, 0 for RSD).
+	Digits uint8
+	// RoundingMode specifies how converted amounts will be rounded.
+	// Defaults to currency.RoundHalfUp.
+	RoundingMode RoundingMode
+}
+
+// NewConverter creates a new converter for the given rate provider.
+func NewConverter(provider RateProvider) *Converter {
+	c := &Converter{
+		provider:     provider,
+		Digits:       DefaultDigits,
+		RoundingMode: RoundHalfUp,
+	}
+	return c
+}
+
+// Convert converts an amount to the given currency, using the rate valid at the given time.
+func (c *Converter) Convert(amount Amount, currencyCode string, at time.Time) (Amount, error) {
+	rate, err := c.Rate(amount.CurrencyCode(), currencyCode, at)
+	if err != nil {
+		return Amount{}, err
+	}
+	result, err := amount.Convert(currencyCode, rate)
+	if err != nil {
+		return Amount{}, err
+	}
+	if !c.NoRounding {
+		result = result.RoundTo(c.Digits, c.RoundingMode)
+	}
+
+	return result, nil
+}
+
+// Rate returns the exchange rate between two currencies, valid at the given time.
+//
+// The rate is first requested from the provider directly, then calculated
+// from the inverse rate, and finally triangulated via the base currency.
+func (c *Converter) Rate(from, to string, at time.Time) (string, error) {
+	if from == "" || !IsValid(from) {
+		return "", InvalidCurrencyCodeError{from}
+	}
+	if to == "" || !IsValid(to) {
+		return "", InvalidCurrencyCodeError{to}
+	}
+	if from == to {
+		return "1", nil
+	}
+	rate, err := c.rate(from, to, at)
+	if isRateNotFound(err) && c.BaseCurrency != "" && from != c.BaseCurrency && to != c.BaseCurrency {
+		rate, err = c.crossRate(from, to, at)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return rate.Text('f'), nil
+}
+
+// rate returns the exchange rate between two currencies, falling back to the inverse rate.
+func (c *Converter) rate(from, to string, at time.Time) (*apd.Decimal, error) {
+	rate, err := c.providerRate(from, to, at)
+	if !isRateNotFound(err) || c.NoInverseRates {
+		return rate, err
+	}
+	inverseRate, inverseErr := c.providerRate(to, from, at)
+	if inverseErr != nil {
+		if isRateNotFound(inverseErr) {
+			// Report the originally requested pair.
+			return nil, err
+		}
+		return nil, inverseErr
+	}
+	if inverseRate.IsZero() {
+		return nil, InvalidNumberError{inverseRate.String()}
+	}
+	result := apd.Decimal{}
+	ctx := decimalContext(inverseRate)
+	ctx.Quo(&result, apd.New(1, 0), inverseRate)
+	result.Reduce(&result)
+
+	return &result, nil
+}
+
+// crossRate returns the exchange rate between two currencies, triangulated via the base currency.
+func (c *Converter) crossRate(from, to string, at time.Time) (*apd.Decimal, error) {
+	fromRate, err := c.rate(from, c.BaseCurrency, at)
+	if err != nil {
+		if isRateNotFound(err) {
+			return nil, RateNotFoundError{from, to}
+		}
+		return nil, err
+	}
+	toRate, err := c.rate(c.BaseCurrency, to, at)
+	if err != nil {
+		if isRateNotFound(err) {
+			return nil, RateNotFoundError{from, to}
+		}
+		return nil, err
+	}
+	result := apd.Decimal{}
+	ctx := decimalContext(fromRate, toRate)
+	ctx.Mul(&result, fromRate, toRate)
+	result.Reduce(&result)
+
+	return &result, nil
+}
+
+// providerRate returns the exchange rate from the provider, as a decimal.
+func (c *Converter) providerRate(from, to string, at time.Time) (*apd.Decimal, error) {
+	rate, err := c.provider.Rate(from, to, at)
+	if err != nil {
+		return nil, err
+	}
+	result := apd.Decimal{}
+	if _, _, err := result.SetString(rate); err != nil {
+		return nil, InvalidNumberError{rate}
+	}
+
+	return &result, nil
+}
+
+// isRateNotFound returns whether err is a RateNotFoundError.
+func isRateNotFound(err error) bool {
+	var e RateNotFoundError
+	return errors.As(err, &e)
+}
+
+// StaticRateProvider is an in-memory RateProvider with fixed rates.
+//
+// The rates are not time-dependent. Useful for tests and for
+// applications which manage their own rates.
+// Safe for concurrent use.
+type StaticRateProvider struct {
+	mu    sync.RWMutex
+	rates map[string]string
+}
+
+// NewStaticRateProvider creates a new static rate provider.
+func NewStaticRateProvider() *StaticRateProvider {
+	return &StaticRateProvider{
+		rates: make(map[string]string),
+	}
+}
+
+// Set sets the exchange rate between two currencies.
+func (p *StaticRateProvider) Set(from, to, rate string) error {
+	if from == "" || !IsValid(from) {
+		return InvalidCurrencyCodeError{from}
+	}
+	if to == "" || !IsValid(to) {
+		return InvalidCurrencyCodeError{to}
+	}
+	number := apd.Decimal{}
+	if _, _, err := number.SetString(rate); err != nil {
+		return InvalidNumberError{rate}
+	}
+	p.mu.Lock()
+	p.rates[from+to] = rate
+	p.mu.Unlock()
+
+	return nil
+}
+
+// Rate implements the RateProvider interface.
+func (p *StaticRateProvider) Rate(from, to string, at time.Time) (string, error) {
+	p.mu.RLock()
+	rate, ok := p.rates[from+to]
+	p.mu.RUnlock()
+	if !ok {
+		return "", RateNotFoundError{from, to}
+	}
+
+	return rate, nil
+}
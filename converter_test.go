@@ -0,0 +1,171 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/plenigo/currency"
+)
+
+type failingProvider struct{}
+
+func (p failingProvider) Rate(from, to string, at time.Time) (string, error) {
+	return "", errors.New("connection refused")
+}
+
+func TestStaticRateProvider(t *testing.T) {
+	provider := currency.NewStaticRateProvider()
+	err := provider.Set("usd", "EUR", "0.91")
+	if e, ok := err.(currency.InvalidCurrencyCodeError); ok {
+		if e.CurrencyCode != "usd" {
+			t.Errorf("got %v, want usd", e.CurrencyCode)
+		}
+	} else {
+		t.Errorf("got %T, want currency.InvalidCurrencyCodeError", err)
+	}
+
+	err = provider.Set("USD", "EUR", "INVALID")
+	if e, ok := err.(currency.InvalidNumberError); ok {
+		if e.Number != "INVALID" {
+			t.Errorf("got %v, want INVALID", e.Number)
+		}
+	} else {
+		t.Errorf("got %T, want currency.InvalidNumberError", err)
+	}
+
+	err = provider.Set("USD", "EUR", "0.91")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	rate, err := provider.Rate("USD", "EUR", time.Now())
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if rate != "0.91" {
+		t.Errorf("got %v, want 0.91", rate)
+	}
+
+	_, err = provider.Rate("EUR", "USD", time.Now())
+	if e, ok := err.(currency.RateNotFoundError); ok {
+		if e.From != "EUR" || e.To != "USD" {
+			t.Errorf("got %v/%v, want EUR/USD", e.From, e.To)
+		}
+		wantError := `no exchange rate from "EUR" to "USD"`
+		if e.Error() != wantError {
+			t.Errorf("got %v, want %v", e.Error(), wantError)
+		}
+	} else {
+		t.Errorf("got %T, want currency.RateNotFoundError", err)
+	}
+}
+
+func TestConverter_Rate(t *testing.T) {
+	provider := currency.NewStaticRateProvider()
+	provider.Set("EUR", "USD", "1.25")
+	provider.Set("EUR", "JPY", "160")
+	provider.Set("GBP", "EUR", "1.2")
+	provider.Set("HUF", "EUR", "0.0025")
+
+	tests := []struct {
+		from           string
+		to             string
+		baseCurrency   string
+		noInverseRates bool
+		want           string
+		wantError      string
+	}{
+		{"EUR", "EUR", "", false, "1", ""},
+		{"EUR", "USD", "", false, "1.25", ""},
+		// Inverse rates.
+		{"USD", "EUR", "", false, "0.8", ""},
+		{"USD", "EUR", "", true, "", `no exchange rate from "USD" to "EUR"`},
+		{"EUR", "HUF", "", false, "400", ""},
+		// Cross rates.
+		{"USD", "JPY", "", false, "", `no exchange rate from "USD" to "JPY"`},
+		{"USD", "JPY", "EUR", false, "128", ""},
+		{"GBP", "USD", "EUR", false, "1.5", ""},
+		{"USD", "GBP", "EUR", true, "", `no exchange rate from "USD" to "GBP"`},
+		{"CHF", "USD", "EUR", false, "", `no exchange rate from "CHF" to "USD"`},
+		// Invalid currency codes.
+		{"usd", "EUR", "", false, "", `invalid currency code "usd"`},
+		{"USD", "", "", false, "", `invalid currency code ""`},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			converter := currency.NewConverter(provider)
+			converter.BaseCurrency = tt.baseCurrency
+			converter.NoInverseRates = tt.noInverseRates
+			got, err := converter.Rate(tt.from, tt.to, time.Now())
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			errStr := ""
+			if err != nil {
+				errStr = err.Error()
+			}
+			if errStr != tt.wantError {
+				t.Errorf("error: got %v, want %v", errStr, tt.wantError)
+			}
+		})
+	}
+
+	converter := currency.NewConverter(failingProvider{})
+	_, err := converter.Rate("USD", "EUR", time.Now())
+	if err == nil || err.Error() != "connection refused" {
+		t.Errorf("got %v, want connection refused", err)
+	}
+}
+
+func TestConverter_Convert(t *testing.T) {
+	provider := currency.NewStaticRateProvider()
+	provider.Set("EUR", "USD", "1.0876")
+	provider.Set("EUR", "JPY", "161.23")
+	provider.Set("HUF", "EUR", "0.0025")
+
+	tests := []struct {
+		number       string
+		currencyCode string
+		to           string
+		noRounding   bool
+		digits       uint8
+		roundingMode currency.RoundingMode
+		want         string
+		wantError    string
+	}{
+		{"10", "EUR", "USD", false, currency.DefaultDigits, currency.RoundHalfUp, "10.88 USD", ""},
+		{"10", "EUR", "USD", true, currency.DefaultDigits, currency.RoundHalfUp, "10.8760 USD", ""},
+		{"10", "EUR", "USD", false, 3, currency.RoundHalfUp, "10.876 USD", ""},
+		{"10", "EUR", "USD", false, currency.DefaultDigits, currency.RoundDown, "10.87 USD", ""},
+		{"10", "EUR", "JPY", false, currency.DefaultDigits, currency.RoundHalfUp, "1612 JPY", ""},
+		// Inverse rates.
+		{"10", "EUR", "HUF", false, currency.DefaultDigits, currency.RoundHalfUp, "4000 HUF", ""},
+		{"10", "EUR", "HUF", true, currency.DefaultDigits, currency.RoundHalfUp, "4000 HUF", ""},
+		{"10", "EUR", "GBP", false, currency.DefaultDigits, currency.RoundHalfUp, "", `no exchange rate from "EUR" to "GBP"`},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			amount, _ := currency.NewAmount(tt.number, tt.currencyCode)
+			converter := currency.NewConverter(provider)
+			converter.NoRounding = tt.noRounding
+			converter.Digits = tt.digits
+			converter.RoundingMode = tt.roundingMode
+			got, err := converter.Convert(amount, tt.to, time.Now())
+			errStr := ""
+			if err != nil {
+				errStr = err.Error()
+			}
+			if errStr != tt.wantError {
+				t.Errorf("error: got %v, want %v", errStr, tt.wantError)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
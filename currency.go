@@ -4,7 +4,13 @@
 // Package currency handles currency amounts, provides currency information and formatting.
 package currency
 
-import "sort"
+import (
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/cockroachdb/apd/v3"
+)
 
 // DefaultDigits is a placeholder for each currency's number of fraction digits.
 const DefaultDigits uint8 = 255
@@ -16,6 +22,22 @@ func ForCountryCode(countryCode string) (currencyCode string, ok bool) {
 	return currencyCode, ok
 }
 
+// ForLocale returns the currency code for a locale.
+//
+// Uses the locale's currency override if set ("en-US-u-cu-eur" => "EUR"),
+// otherwise the currency of the locale's region override ("en-u-rg-gbzzzz"
+// => "GBP") or territory ("de-CH" => "CHF").
+func ForLocale(locale Locale) (currencyCode string, ok bool) {
+	if locale.CurrencyCode != "" && IsValid(locale.CurrencyCode) {
+		return locale.CurrencyCode, true
+	}
+	region := locale.region()
+	if region == "" {
+		return "", false
+	}
+	return ForCountryCode(region)
+}
+
 // GetCurrencyCodes returns all known currency codes.
 func GetCurrencyCodes() []string {
 	return currencyCodes
@@ -41,6 +63,26 @@ func GetNumericCode(currencyCode string) (numericCode string, ok bool) {
 	return currencies[currencyCode].numericCode, true
 }
 
+var numericCodes struct {
+	sync.Once
+	currencyCodes map[uint64]string
+}
+
+// forNumericCode returns the currency code for a numeric code (e.g. 840 => "USD").
+func forNumericCode(numericCode uint64) (currencyCode string, ok bool) {
+	numericCodes.Do(func() {
+		numericCodes.currencyCodes = make(map[uint64]string, len(currencies))
+		for currencyCode, info := range currencies {
+			if n, err := strconv.ParseUint(info.numericCode, 10, 64); err == nil {
+				numericCodes.currencyCodes[n] = currencyCode
+			}
+		}
+	})
+	currencyCode, ok = numericCodes.currencyCodes[numericCode]
+
+	return currencyCode, ok
+}
+
 // GetDigits returns the number of fraction digits for a currency code.
 func GetDigits(currencyCode string) (digits uint8, ok bool) {
 	if currencyCode == "" || !IsValid(currencyCode) {
@@ -49,31 +91,151 @@ func GetDigits(currencyCode string) (digits uint8, ok bool) {
 	return currencies[currencyCode].digits, true
 }
 
+// GetCashDigits returns the number of fraction digits used for cash payments.
+//
+// Usually the same as GetDigits, but some currencies don't use their
+// smallest coins (e.g. SEK has 2 digits, but 0 cash digits).
+func GetCashDigits(currencyCode string) (digits uint8, ok bool) {
+	if currencyCode == "" || !IsValid(currencyCode) {
+		return 0, false
+	}
+	if cash, ok := cashRoundings[currencyCode]; ok {
+		return cash.digits, true
+	}
+	return currencies[currencyCode].digits, true
+}
+
+// GetCashIncrement returns the rounding increment used for cash payments.
+//
+// For example, "0.05" for CHF, "0.50" for DKK, "1" for SEK, "0.01" for EUR.
+func GetCashIncrement(currencyCode string) (increment string, ok bool) {
+	if currencyCode == "" || !IsValid(currencyCode) {
+		return "0", false
+	}
+	return cashIncrement(currencyCode).String(), true
+}
+
+// cashIncrement returns the cash rounding increment for a currency code.
+func cashIncrement(currencyCode string) *apd.Decimal {
+	digits := currencies[currencyCode].digits
+	rounding := int64(1)
+	if cash, ok := cashRoundings[currencyCode]; ok {
+		digits = cash.digits
+		rounding = int64(cash.rounding)
+	}
+
+	return apd.New(rounding, -int32(digits))
+}
+
 // GetSymbol returns the symbol for a currency code.
 func GetSymbol(currencyCode string, locale Locale) (symbol string, ok bool) {
 	if currencyCode == "" || !IsValid(currencyCode) {
 		return currencyCode, false
 	}
+
+	return getSymbol(currencyCode, symbolLocaleIDs(locale))
+}
+
+// getSymbol returns the symbol for a valid currency code.
+//
+// The locale IDs are the locale and its parents, as returned by symbolLocaleIDs.
+func getSymbol(currencyCode string, localeIDs []string) (symbol string, ok bool) {
 	symbols, ok := currencySymbols[currencyCode]
 	if !ok {
 		return currencyCode, true
 	}
+	if len(localeIDs) == 0 {
+		// The "en"/"en-US" symbol is always first.
+		return symbols[0].symbol, true
+	}
+	for _, localeID := range localeIDs {
+		for _, s := range symbols {
+			if contains(s.locales, localeID) {
+				return s.symbol, true
+			}
+		}
+	}
+
+	return "", true
+}
+
+// symbolLocaleIDs returns the IDs of the locale and its parents, used for symbol lookups.
+//
+// Returns nil for the "en" and "en-US" locales, which use the first symbol.
+func symbolLocaleIDs(locale Locale) []string {
+	locale = locale.base()
 	enLocale := Locale{Language: "en"}
 	enUSLocale := Locale{Language: "en", Territory: "US"}
 	if locale == enLocale || locale == enUSLocale || locale.IsEmpty() {
-		// The "en"/"en-US" symbol is always first.
-		return symbols[0].symbol, true
+		return nil
 	}
+	var localeIDs []string
+	for ; !locale.IsEmpty(); locale = locale.GetParent() {
+		localeIDs = append(localeIDs, locale.String())
+	}
+
+	return localeIDs
+}
+
+// GetDisplayName returns the display name for a currency code.
+//
+// For example, "US Dollar" for "USD" in the "en" locale.
+// Falls back to the currency code if no name is available, which is
+// always the case unless built with the "currency_names" tag.
+func GetDisplayName(currencyCode string, locale Locale) (displayName string, ok bool) {
+	if currencyCode == "" || !IsValid(currencyCode) {
+		return currencyCode, false
+	}
+	name, ok := getName(currencyCode, locale)
+	if !ok {
+		return currencyCode, false
+	}
+
+	return name.displayName, true
+}
 
+// getFormat returns the format for a locale.
+//
+// Also returns the locale the format was found for, after parent fallback.
+func getFormat(locale Locale) (currencyFormat, Locale) {
+	// CLDR considers "en" and "en-US" to be equivalent.
+	// Fall back immediately for better performance
+	locale = locale.base()
+	enLocale := Locale{Language: "en"}
+	enUSLocale := Locale{Language: "en", Territory: "US"}
+	if locale == enUSLocale || locale.IsEmpty() {
+		return currencyFormats["en"], enLocale
+	}
+
+	var format currencyFormat
 	for {
 		localeID := locale.String()
-		for _, s := range symbols {
-			if contains(s.locales, localeID) {
-				symbol = s.symbol
-				break
-			}
+		if cf, ok := currencyFormats[localeID]; ok {
+			format = cf
+			break
+		}
+		locale = locale.GetParent()
+		if locale.IsEmpty() {
+			break
 		}
-		if symbol != "" {
+	}
+
+	return format, locale
+}
+
+// getCompactFormat returns the compact format for a locale.
+func getCompactFormat(locale Locale) []string {
+	locale = locale.base()
+	enUSLocale := Locale{Language: "en", Territory: "US"}
+	if locale == enUSLocale || locale.IsEmpty() {
+		return compactFormats["en"]
+	}
+
+	var compactFormat []string
+	for {
+		localeID := locale.String()
+		if cf, ok := compactFormats[localeID]; ok {
+			compactFormat = cf
 			break
 		}
 		locale = locale.GetParent()
@@ -82,23 +244,22 @@ func GetSymbol(currencyCode string, locale Locale) (symbol string, ok bool) {
 		}
 	}
 
-	return symbol, true
+	return compactFormat
 }
 
-// getFormat returns the format for a locale.
-func getFormat(locale Locale) currencyFormat {
-	// CLDR considers "en" and "en-US" to be equivalent.
-	// Fall back immediately for better performance
+// getRangeFormat returns the range format for a locale.
+func getRangeFormat(locale Locale) rangeFormat {
+	locale = locale.base()
 	enUSLocale := Locale{Language: "en", Territory: "US"}
 	if locale == enUSLocale || locale.IsEmpty() {
-		return currencyFormats["en"]
+		return rangeFormats["en"]
 	}
 
-	var format currencyFormat
+	var format rangeFormat
 	for {
 		localeID := locale.String()
-		if cf, ok := currencyFormats[localeID]; ok {
-			format = cf
+		if rf, ok := rangeFormats[localeID]; ok {
+			format = rf
 			break
 		}
 		locale = locale.GetParent()
@@ -33,6 +33,35 @@ func TestForCountryCode(t *testing.T) {
 	}
 }
 
+func TestForLocale(t *testing.T) {
+	tests := []struct {
+		localeID         string
+		wantCurrencyCode string
+		wantOK           bool
+	}{
+		{"de-CH", "CHF", true},
+		{"en-US-u-cu-eur", "EUR", true},
+		{"en-u-rg-gbzzzz", "GBP", true},
+		{"en-US-u-rg-frzzzz", "EUR", true},
+		{"en-US-u-cu-xxx", "USD", true},
+		{"en", "", false},
+		{"en-XX", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.localeID, func(t *testing.T) {
+			locale := currency.NewLocale(tt.localeID)
+			gotCurrencyCode, gotOK := currency.ForLocale(locale)
+			if gotOK != tt.wantOK {
+				t.Errorf("got %v, want %v", gotOK, tt.wantOK)
+			}
+			if gotCurrencyCode != tt.wantCurrencyCode {
+				t.Errorf("got %q, want %q", gotCurrencyCode, tt.wantCurrencyCode)
+			}
+		})
+	}
+}
+
 func TestGetCurrencyCodes(t *testing.T) {
 	currencyCodes := currency.GetCurrencyCodes()
 	var got [10]string
@@ -105,6 +134,59 @@ func TestGetDigits(t *testing.T) {
 	}
 }
 
+func TestGetCashDigits(t *testing.T) {
+	tests := []struct {
+		currencyCode string
+		wantDigits   uint8
+		wantOk       bool
+	}{
+		{"USD", 2, true},
+		{"CHF", 2, true},
+		{"SEK", 0, true},
+		{"JPY", 0, true},
+		{"XXX", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.currencyCode, func(t *testing.T) {
+			digits, ok := currency.GetCashDigits(tt.currencyCode)
+			if ok != tt.wantOk {
+				t.Errorf("got %v, want %v", ok, tt.wantOk)
+			}
+			if digits != tt.wantDigits {
+				t.Errorf("got %v, want %v", digits, tt.wantDigits)
+			}
+		})
+	}
+}
+
+func TestGetCashIncrement(t *testing.T) {
+	tests := []struct {
+		currencyCode  string
+		wantIncrement string
+		wantOk        bool
+	}{
+		{"EUR", "0.01", true},
+		{"CHF", "0.05", true},
+		{"DKK", "0.50", true},
+		{"SEK", "1", true},
+		{"JPY", "1", true},
+		{"XXX", "0", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.currencyCode, func(t *testing.T) {
+			increment, ok := currency.GetCashIncrement(tt.currencyCode)
+			if ok != tt.wantOk {
+				t.Errorf("got %v, want %v", ok, tt.wantOk)
+			}
+			if increment != tt.wantIncrement {
+				t.Errorf("got %v, want %v", increment, tt.wantIncrement)
+			}
+		})
+	}
+}
+
 func TestGetSymbol(t *testing.T) {
 	tests := []struct {
 		currencyCode string
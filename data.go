@@ -4,24 +4,18 @@
 package currency
 
 // CLDRVersion is the CLDR version from which the data is derived.
-const CLDRVersion = "45.0.0"
-
-type numberingSystem uint8
-
-const (
-	numLatn numberingSystem = iota
-	numArab
-	numArabExt
-	numBeng
-	numDeva
-	numMymr
-)
+const CLDRVersion = "48.0.0"
 
 type currencyInfo struct {
 	numericCode string
 	digits      uint8
 }
 
+type cashInfo struct {
+	digits   uint8
+	rounding uint8
+}
+
 type symbolInfo struct {
 	symbol  string
 	locales []string
@@ -30,7 +24,7 @@ type symbolInfo struct {
 type currencyFormat struct {
 	standardPattern       string
 	accountingPattern     string
-	numberingSystem       numberingSystem
+	numberingSystem       NumberingSystem
 	minGroupingDigits     uint8
 	primaryGroupingSize   uint8
 	secondaryGroupingSize uint8
@@ -40,6 +34,11 @@ type currencyFormat struct {
 	minusSign             string
 }
 
+type rangeFormat struct {
+	rangePattern         string
+	approximatelyPattern string
+}
+
 // Defined separately to ensure consistent ordering (G10, then others).
 var currencyCodes = []string{
 	// G10 currencies https://en.wikipedia.org/wiki/G10_currencies.
@@ -76,7 +75,7 @@ var currencies = map[string]currencyInfo{
 	"BYN": {"933", 2}, "BZD": {"084", 2}, "CAD": {"124", 2},
 	"CDF": {"976", 2}, "CHE": {"947", 2}, "CHF": {"756", 2},
 	"CHW": {"948", 2}, "CLF": {"990", 4}, "CLP": {"152", 0},
-	"CNY": {"156", 2}, "COP": {"170", 2}, "COU": {"970", 2},
+	"CNY": {"156", 2}, "COP": {"170", 0}, "COU": {"970", 2},
 	"CRC": {"188", 2}, "CUC": {"931", 2}, "CUP": {"192", 2},
 	"CVE": {"132", 2}, "CZK": {"203", 2}, "DJF": {"262", 0},
 	"DKK": {"208", 2}, "DOP": {"214", 2}, "DZD": {"012", 2},
@@ -85,8 +84,8 @@ var currencies = map[string]currencyInfo{
 	"GBP": {"826", 2}, "GEL": {"981", 2}, "GHS": {"936", 2},
 	"GIP": {"292", 2}, "GMD": {"270", 2}, "GNF": {"324", 0},
 	"GTQ": {"320", 2}, "GYD": {"328", 2}, "HKD": {"344", 2},
-	"HNL": {"340", 2}, "HTG": {"332", 2}, "HUF": {"348", 2},
-	"IDR": {"360", 2}, "ILS": {"376", 2}, "INR": {"356", 2},
+	"HNL": {"340", 2}, "HTG": {"332", 2}, "HUF": {"348", 0},
+	"IDR": {"360", 0}, "ILS": {"376", 2}, "INR": {"356", 2},
 	"IQD": {"368", 0}, "IRR": {"364", 0}, "ISK": {"352", 0},
 	"JMD": {"388", 2}, "JOD": {"400", 3}, "JPY": {"392", 0},
 	"KES": {"404", 2}, "KGS": {"417", 2}, "KHR": {"116", 2},
@@ -102,9 +101,9 @@ var currencies = map[string]currencyInfo{
 	"NAD": {"516", 2}, "NGN": {"566", 2}, "NIO": {"558", 2},
 	"NOK": {"578", 2}, "NPR": {"524", 2}, "NZD": {"554", 2},
 	"OMR": {"512", 3}, "PAB": {"590", 2}, "PEN": {"604", 2},
-	"PGK": {"598", 2}, "PHP": {"608", 2}, "PKR": {"586", 2},
+	"PGK": {"598", 2}, "PHP": {"608", 2}, "PKR": {"586", 0},
 	"PLN": {"985", 2}, "PYG": {"600", 0}, "QAR": {"634", 2},
-	"RON": {"946", 2}, "RSD": {"941", 0}, "RUB": {"643", 2},
+	"RON": {"946", 2}, "RSD": {"941", 2}, "RUB": {"643", 2},
 	"RWF": {"646", 0}, "SAR": {"682", 2}, "SBD": {"090", 2},
 	"SCR": {"690", 2}, "SDG": {"938", 2}, "SEK": {"752", 2},
 	"SGD": {"702", 2}, "SHP": {"654", 2}, "SLE": {"925", 2},
@@ -123,6 +122,15 @@ var currencies = map[string]currencyInfo{
 	"ZWL": {"932", 2},
 }
 
+var cashRoundings = map[string]cashInfo{
+	"AMD": {0, 1}, "CAD": {2, 5}, "CHF": {2, 5},
+	"CRC": {0, 1}, "CZK": {0, 1}, "DKK": {2, 50},
+	"GYD": {0, 1}, "HUF": {0, 5}, "MNT": {0, 1},
+	"MUR": {0, 1}, "NOK": {0, 1}, "RSD": {0, 1},
+	"SEK": {0, 1}, "TWD": {0, 1}, "TZS": {0, 1},
+	"UZS": {0, 1},
+}
+
 var currencySymbols = map[string][]symbolInfo{
 	"AED": {
 		{"AED", []string{"en"}},
@@ -256,7 +264,6 @@ var currencySymbols = map[string][]symbolInfo{
 		{"¥CN", []string{"fa"}},
 		{"\u200eCN¥\u200e", []string{"he"}},
 		{"元", []string{"ja"}},
-		{"￥", []string{"yue-Hans"}},
 	},
 	"COP": {
 		{"COP", []string{"en", "fr-CA"}},
@@ -271,10 +278,6 @@ var currencySymbols = map[string][]symbolInfo{
 		{"CUP", []string{"en"}},
 		{"$", []string{"es-CU"}},
 	},
-	"CVE": {
-		{"CVE", []string{"en"}},
-		{"\u200b", []string{"pt-CV"}},
-	},
 	"CZK": {
 		{"CZK", []string{"en"}},
 		{"Kč", []string{"cs"}},
@@ -333,7 +336,7 @@ var currencySymbols = map[string][]symbolInfo{
 	},
 	"GEL": {
 		{"GEL", []string{"en"}},
-		{"₾", []string{"ka"}},
+		{"₾", []string{"en-GE", "ka"}},
 	},
 	"GHS": {
 		{"GHS", []string{"en"}},
@@ -414,7 +417,7 @@ var currencySymbols = map[string][]symbolInfo{
 	},
 	"JPY": {
 		{"¥", []string{"en", "en-AU"}},
-		{"JP¥", []string{"af", "am", "ar", "as", "az", "bn", "chr", "cs", "cy", "da", "el", "el-polyton", "en-001", "en-CA", "eu", "gd", "gl", "gu", "hi", "hy", "id", "is", "kk", "km", "ko", "kok", "ky", "lo", "mn", "mr", "ms", "my", "ne", "nl", "pa", "ps", "pt", "si", "so", "sq", "sw", "te", "tk", "ur", "uz", "yue-Hans", "zh", "zu"}},
+		{"JP¥", []string{"af", "am", "ar", "as", "az", "bn", "chr", "cs", "cy", "da", "el", "en-001", "en-CA", "eu", "gd", "gl", "gu", "hi", "hy", "id", "is", "kk", "km", "ko", "kok", "kok-Latn", "ky", "lo", "mn", "mr", "ms", "my", "ne", "nl", "pa", "ps", "pt", "si", "so", "sq", "sw", "te", "tk", "ur", "uz", "zh", "zu"}},
 		{"￥", []string{"ja"}},
 	},
 	"KES": {
@@ -435,7 +438,7 @@ var currencySymbols = map[string][]symbolInfo{
 	},
 	"KRW": {
 		{"₩", []string{"en", "zh-Hant-HK"}},
-		{"￦", []string{"yue", "yue-Hans", "zh", "zh-Hant"}},
+		{"￦", []string{"yue", "yue-Hans", "zh-Hant"}},
 	},
 	"KWD": {
 		{"KWD", []string{"en"}},
@@ -551,7 +554,7 @@ var currencySymbols = map[string][]symbolInfo{
 	"NOK": {
 		{"NOK", []string{"en"}},
 		{"Nkr", []string{"sv"}},
-		{"kr", []string{"no"}},
+		{"kr", []string{"en-NO", "no"}},
 	},
 	"NPR": {
 		{"NPR", []string{"en"}},
@@ -680,7 +683,7 @@ var currencySymbols = map[string][]symbolInfo{
 	},
 	"THB": {
 		{"THB", []string{"en", "es-419"}},
-		{"฿", []string{"af", "am", "ar", "az", "bn", "bs", "ca", "cy", "da", "de", "dsb", "el", "el-polyton", "es", "et", "eu", "fa", "fil", "ga", "gd", "gl", "gu", "he", "hi", "hsb", "hy", "id", "it", "kk", "km", "ky", "lo", "lv", "mn", "mr", "my", "ne", "nl", "pa", "pt", "ru", "si", "sq", "sw", "ta", "te", "th", "tr", "ur", "vi", "zu"}},
+		{"฿", []string{"af", "am", "ar", "az", "bn", "bs", "ca", "cy", "da", "de", "dsb", "el", "es", "et", "eu", "fa", "fil", "ga", "gd", "gl", "gu", "he", "hi", "hsb", "hy", "id", "it", "kk", "km", "ky", "lo", "lv", "mn", "mr", "my", "ne", "nl", "pa", "pt", "ru", "si", "sq", "sw", "ta", "te", "th", "tr", "ur", "vi", "zu"}},
 	},
 	"TMT": {
 		{"TMT", []string{"en"}},
@@ -695,10 +698,6 @@ var currencySymbols = map[string][]symbolInfo{
 		{"TOP", []string{"en"}},
 		{"T$", []string{"en-TO"}},
 	},
-	"TRY": {
-		{"TRY", []string{"en"}},
-		{"₺", []string{"tr"}},
-	},
 	"TTD": {
 		{"TTD", []string{"en", "fr-CA"}},
 		{"$", []string{"en-TT"}},
@@ -725,7 +724,7 @@ var currencySymbols = map[string][]symbolInfo{
 		{"$", []string{"bn-IN", "en", "en-IN", "es-419", "nl-BQ", "sw-KE"}},
 		{"$US", []string{"fr"}},
 		{"$\u00a0US", []string{"fr-CA"}},
-		{"US$", []string{"am", "ar", "as", "az", "bn", "cs", "cy", "da", "en-001", "en-CA", "es", "es-AR", "es-CL", "es-CO", "es-CU", "es-DO", "es-UY", "eu", "gu", "id", "ka", "ko", "kok", "lo", "mk", "my", "ne", "nl", "pa", "pt", "si", "so", "sq", "sr", "sr-Latn", "sv", "sw", "ta-SG", "th", "tk", "uz", "vi", "yue", "yue-Hans", "zh", "zh-Hant"}},
+		{"US$", []string{"am", "ar", "as", "az", "bn", "cs", "cy", "da", "en-001", "en-CA", "es", "es-AR", "es-CL", "es-CO", "es-CU", "es-DO", "es-UY", "eu", "gu", "id", "ka", "ko", "kok", "kok-Latn", "lo", "mk", "my", "ne", "nl", "pa", "pt", "si", "so", "sq", "sr", "sr-Latn", "sv", "sw", "ta-SG", "th", "tk", "uz", "vi", "yue", "yue-Hans", "zh", "zh-Hant"}},
 		{"щ.д.", []string{"bg"}},
 	},
 	"UYU": {
@@ -790,128 +789,381 @@ var currencySymbols = map[string][]symbolInfo{
 }
 
 var currencyFormats = map[string]currencyFormat{
-	"af":         {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
-	"ar":         {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
-	"ar-AE":      {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
-	"ar-DZ":      {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ",", ".", "\u200e+", "\u200e-"},
-	"ar-EH":      {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
-	"ar-LY":      {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ",", ".", "\u200e+", "\u200e-"},
-	"ar-MA":      {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ",", ".", "\u200e+", "\u200e-"},
-	"ar-TN":      {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ",", ".", "\u200e+", "\u200e-"},
-	"as":         {"¤\u00a00.00", "¤0.00;(¤0.00)", 3, 1, 3, 2, ".", ",", "+", "-"},
-	"az":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"be":         {"0.00\u00a0¤", "", 0, 2, 3, 3, ",", "\u00a0", "+", "-"},
-	"bg":         {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 2, 3, 3, ",", "\u00a0", "+", "-"},
-	"bn":         {"0.00¤", "0.00¤;(0.00¤)", 3, 1, 3, 2, ".", ",", "+", "-"},
-	"bn-IN":      {"¤0.00", "¤0.00;(¤0.00)", 3, 1, 3, 2, ".", ",", "+", "-"},
-	"bs":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"ca":         {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"cs":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
-	"da":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"de":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"de-AT":      {"¤\u00a00.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"de-CH":      {"¤\u00a00.00;¤-0.00", "", 0, 1, 3, 3, ".", "’", "+", "-"},
-	"de-LI":      {"¤\u00a00.00", "", 0, 1, 3, 3, ".", "’", "+", "-"},
-	"dsb":        {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"el":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"el-polyton": {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"en":         {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ".", ",", "+", "-"},
-	"en-150":     {"0.00\u00a0¤", "", 0, 1, 3, 3, ".", ",", "+", "-"},
-	"en-AT":      {"¤\u00a00.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"en-BE":      {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"en-CH":      {"¤\u00a00.00;¤-0.00", "", 0, 1, 3, 3, ".", "’", "+", "-"},
-	"en-DE":      {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"en-DK":      {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"en-FI":      {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
-	"en-ID":      {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"en-IN":      {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 2, ".", ",", "+", "-"},
-	"en-MV":      {"¤\u00a00.00", "", 0, 1, 3, 3, ".", ",", "+", "-"},
-	"en-NL":      {"¤\u00a00.00;¤\u00a0-0.00", "¤\u00a00.00;(¤\u00a00.00)", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"en-SE":      {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
-	"en-SI":      {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"es":         {"0.00\u00a0¤", "", 0, 2, 3, 3, ",", ".", "+", "-"},
-	"es-419":     {"¤0.00", "", 0, 1, 3, 3, ".", ",", "+", "-"},
-	"es-AR":      {"¤\u00a00.00", "¤\u00a00.00;(¤\u00a00.00)", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"es-BO":      {"¤0.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"es-CL":      {"¤0.00;¤-0.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"es-CO":      {"¤\u00a00.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"es-CR":      {"¤0.00", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
-	"es-DO":      {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ".", ",", "+", "-"},
-	"es-EC":      {"¤0.00;¤-0.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"es-GQ":      {"¤0.00", "", 0, 2, 3, 3, ",", ".", "+", "-"},
-	"es-PE":      {"¤\u00a00.00", "", 0, 1, 3, 3, ".", ",", "+", "-"},
-	"es-PY":      {"¤\u00a00.00;¤\u00a0-0.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"es-UY":      {"¤\u00a00.00", "¤\u00a00.00;(¤\u00a00.00)", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"es-VE":      {"¤0.00;¤-0.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"et":         {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 2, 3, 3, ",", "\u00a0", "+", "−"},
-	"eu":         {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", ".", "+", "−"},
-	"fa":         {"\u200e¤0.00", "\u200e¤\u00a00.00;\u200e(¤\u00a00.00)", 2, 1, 3, 3, "٫", "٬", "\u200e+", "\u200e−"},
-	"fa-AF":      {"¤\u00a00.00", "¤\u00a00.00;\u200e(¤\u00a00.00)", 2, 1, 3, 3, "٫", "٬", "\u200e+", "\u200e−"},
-	"fi":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "−"},
-	"fr":         {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", "\u202f", "+", "-"},
-	"fr-CA":      {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
-	"fr-CH":      {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ".", "\u202f", "+", "-"},
-	"fr-LU":      {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"fr-MA":      {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"gl":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"gu":         {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 2, ".", ",", "+", "-"},
-	"he":         {"\u200f0.00\u00a0\u200f¤;\u200f-0.00\u00a0\u200f¤", "", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
-	"hi":         {"¤0.00", "", 0, 1, 3, 2, ".", ",", "+", "-"},
-	"hi-Latn":    {"¤0.00", "", 0, 1, 3, 2, ".", ",", "+", "-"},
-	"hr":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "−"},
-	"hsb":        {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"hu":         {"0.00\u00a0¤", "", 0, 2, 3, 3, ",", "\u00a0", "+", "-"},
-	"hy":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
-	"id":         {"¤0.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"is":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"it":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"it-CH":      {"¤\u00a00.00;¤-0.00", "", 0, 1, 3, 3, ".", "’", "+", "-"},
-	"ka":         {"0.00\u00a0¤", "", 0, 2, 3, 3, ",", "\u00a0", "+", "-"},
-	"kk":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
-	"km":         {"0.00¤", "0.00¤;(0.00¤)", 0, 1, 3, 3, ".", ",", "+", "-"},
-	"kok":        {"¤\u00a00.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ".", ",", "+", "-"},
-	"ky":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
-	"lo":         {"¤0.00;¤-0.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"lt":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "−"},
-	"lv":         {"0.00\u00a0¤", "", 0, 2, 3, 3, ",", "\u00a0", "+", "-"},
-	"mk":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"mn":         {"¤\u00a00.00", "", 0, 1, 3, 3, ".", ",", "+", "-"},
-	"mr":         {"¤0.00", "¤0.00;(¤0.00)", 4, 1, 3, 3, ".", ",", "+", "-"},
-	"ms-BN":      {"¤\u00a00.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"ms-ID":      {"¤0.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"my":         {"0.00\u00a0¤", "¤\u00a00.00", 5, 1, 3, 3, ".", ",", "+", "-"},
-	"ne":         {"¤\u00a00.00", "", 4, 1, 3, 2, ".", ",", "+", "-"},
-	"nl":         {"¤\u00a00.00;¤\u00a0-0.00", "¤\u00a00.00;(¤\u00a00.00)", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"nn":         {"0.00\u00a0¤;-0.00\u00a0¤", "0.00\u00a0¤", 0, 1, 3, 3, ",", "\u00a0", "+", "−"},
-	"no":         {"0.00\u00a0¤;-0.00\u00a0¤", "¤\u00a00.00;(¤\u00a00.00)", 0, 1, 3, 3, ",", "\u00a0", "+", "−"},
-	"pa":         {"¤0.00", "¤\u00a00.00", 0, 1, 3, 2, ".", ",", "+", "-"},
-	"pl":         {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 2, 3, 3, ",", "\u00a0", "+", "-"},
-	"ps":         {"¤\u00a00.00", "¤0.00;(¤0.00)", 2, 1, 3, 3, "٫", "٬", "\u200e+\u200e", "\u200e-\u200e"},
-	"pt":         {"¤\u00a00.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"pt-AO":      {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
-	"pt-PT":      {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 2, 3, 3, ",", "\u00a0", "+", "-"},
-	"ro":         {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"ru":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
-	"ru-UA":      {"0.00\u00a0¤", "", 0, 2, 3, 3, ",", "\u00a0", "+", "-"},
-	"sk":         {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
-	"sl":         {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", ".", "+", "−"},
-	"sq":         {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 2, 3, 3, ",", "\u00a0", "+", "-"},
-	"sr":         {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"sr-Latn":    {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"sv":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "−"},
-	"sw":         {"¤\u00a00.00", "", 0, 1, 3, 3, ".", ",", "+", "-"},
-	"sw-CD":      {"¤\u00a00.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"ta":         {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 2, ".", ",", "+", "-"},
-	"ta-MY":      {"¤\u00a00.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ".", ",", "+", "-"},
-	"ta-SG":      {"¤\u00a00.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ".", ",", "+", "-"},
-	"te":         {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 2, ".", ",", "+", "-"},
-	"tk":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
-	"tr":         {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ",", ".", "+", "-"},
-	"uk":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
-	"ur":         {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
-	"ur-IN":      {"¤\u00a00.00", "¤0.00;(¤0.00)", 2, 1, 3, 2, "٫", "٬", "\u200e+\u200e", "\u200e-\u200e"},
-	"uz":         {"0.00\u00a0¤", "¤0.00;(¤0.00)", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
-	"vi":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"af":          {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"ar":          {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	"ar-BH":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"ar-DJ":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"ar-DZ":       {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ",", ".", "\u200e+", "\u200e-"},
+	"ar-EG":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"ar-ER":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"ar-IL":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"ar-IQ":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"ar-JO":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"ar-KM":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"ar-KW":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"ar-LB":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"ar-LY":       {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ",", ".", "\u200e+", "\u200e-"},
+	"ar-MA":       {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ",", ".", "\u200e+", "\u200e-"},
+	"ar-MR":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"ar-OM":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"ar-PS":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"ar-QA":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"ar-SA":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"ar-SD":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"ar-SO":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"ar-SS":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"ar-SY":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"ar-TD":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"ar-TN":       {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ",", ".", "\u200e+", "\u200e-"},
+	"ar-YE":       {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	"as":          {"¤\u00a00.00", "¤0.00;(¤0.00)", 3, 1, 3, 2, ".", ",", "+", "-"},
+	"az":          {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"be":          {"0.00\u00a0¤", "", 0, 2, 3, 3, ",", "\u00a0", "+", "-"},
+	"bg":          {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 2, 3, 3, ",", "\u00a0", "+", "-"},
+	"bn":          {"0.00¤", "0.00¤;(0.00¤)", 3, 1, 3, 2, ".", ",", "+", "-"},
+	"bn-IN":       {"¤0.00", "¤0.00;(¤0.00)", 3, 1, 3, 2, ".", ",", "+", "-"},
+	"bs":          {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"ca":          {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"cs":          {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"da":          {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"de":          {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"de-AT":       {"¤\u00a00.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"de-CH":       {"¤\u00a00.00;¤-0.00", "", 0, 1, 3, 3, ".", "'", "+", "-"},
+	"de-LI":       {"¤\u00a00.00", "", 0, 1, 3, 3, ".", "'", "+", "-"},
+	"dsb":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"el":          {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"en":          {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ".", ",", "+", "-"},
+	"en-150":      {"0.00\u00a0¤", "", 0, 1, 3, 3, ".", ",", "+", "-"},
+	"en-AT":       {"¤\u00a00.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"en-BE":       {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"en-CH":       {"¤\u00a00.00;¤-0.00", "", 0, 1, 3, 3, ".", "'", "+", "-"},
+	"en-CZ":       {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"en-DE":       {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"en-DK":       {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"en-EE":       {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"en-ES":       {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"en-FI":       {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"en-FR":       {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u202f", "+", "-"},
+	"en-GE":       {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u202f", "+", "-"},
+	"en-HU":       {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"en-ID":       {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"en-IN":       {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 2, ".", ",", "+", "-"},
+	"en-IT":       {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"en-LT":       {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"en-LV":       {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"en-MV":       {"¤\u00a00.00", "", 0, 1, 3, 3, ".", ",", "+", "-"},
+	"en-NL":       {"¤\u00a00.00;¤\u00a0-0.00", "¤\u00a00.00;(¤\u00a00.00)", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"en-NO":       {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"en-PL":       {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"en-PT":       {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"en-RO":       {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"en-SE":       {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"en-SI":       {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"en-SK":       {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"en-UA":       {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"en-US-POSIX": {"¤\u00a00.00", "¤0.00;(¤0.00)", 0, 1, 0, 0, ".", ",", "+", "-"},
+	"en-ZA":       {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"es":          {"0.00\u00a0¤", "", 0, 2, 3, 3, ",", ".", "+", "-"},
+	"es-419":      {"¤0.00", "", 0, 1, 3, 3, ".", ",", "+", "-"},
+	"es-AR":       {"¤\u00a00.00", "¤\u00a00.00;(¤\u00a00.00)", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"es-BO":       {"¤0.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"es-CL":       {"¤0.00;¤-0.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"es-CO":       {"¤\u00a00.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"es-CR":       {"¤0.00", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"es-DO":       {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ".", ",", "+", "-"},
+	"es-EC":       {"¤0.00;¤-0.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"es-GQ":       {"¤0.00", "", 0, 2, 3, 3, ",", ".", "+", "-"},
+	"es-PE":       {"¤\u00a00.00", "", 0, 1, 3, 3, ".", ",", "+", "-"},
+	"es-PY":       {"¤\u00a00.00;¤\u00a0-0.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"es-UY":       {"¤\u00a00.00", "¤\u00a00.00;(¤\u00a00.00)", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"es-VE":       {"¤0.00;¤-0.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"et":          {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 2, 3, 3, ",", "\u00a0", "+", "−"},
+	"eu":          {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", ".", "+", "−"},
+	"fa":          {"\u200e¤0.00", "", 2, 1, 3, 3, "٫", "٬", "\u200e+", "\u200e−"},
+	"fa-AF":       {"¤\u00a00.00", "", 2, 1, 3, 3, "٫", "٬", "\u200e+", "\u200e−"},
+	"fi":          {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "−"},
+	"fr":          {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", "\u202f", "+", "-"},
+	"fr-CA":       {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"fr-CH":       {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ".", "\u202f", "+", "-"},
+	"fr-LU":       {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"fr-MA":       {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"gl":          {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"gu":          {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 2, ".", ",", "+", "-"},
+	"he":          {"\u200f0.00\u00a0\u200f¤;\u200f-0.00\u00a0\u200f¤", "", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	"hi":          {"¤0.00", "", 0, 1, 3, 2, ".", ",", "+", "-"},
+	"hi-Latn":     {"¤0.00", "", 0, 1, 3, 2, ".", ",", "+", "-"},
+	"hr":          {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "−"},
+	"hsb":         {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"hu":          {"0.00\u00a0¤", "", 0, 2, 3, 3, ",", "\u00a0", "+", "-"},
+	"hy":          {"0.00\u00a0¤", "", 0, 2, 3, 3, ",", "\u00a0", "+", "-"},
+	"id":          {"¤0.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"is":          {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"it":          {"0.00\u00a0¤", "", 0, 2, 3, 3, ",", ".", "+", "-"},
+	"it-CH":       {"¤\u00a00.00;¤-0.00", "", 0, 2, 3, 3, ".", "'", "+", "-"},
+	"ka":          {"0.00\u00a0¤", "", 0, 2, 3, 3, ",", "\u00a0", "+", "-"},
+	"kk":          {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"km":          {"0.00¤", "0.00¤;(0.00¤)", 0, 1, 3, 3, ".", ",", "+", "-"},
+	"kok":         {"¤0.00", "", 0, 1, 3, 2, ".", ",", "+", "-"},
+	"kok-Latn":    {"¤0.00", "", 0, 1, 3, 2, ".", ",", "+", "-"},
+	"ky":          {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"lo":          {"¤0.00;¤-0.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"lt":          {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "−"},
+	"lv":          {"0.00\u00a0¤", "", 0, 2, 3, 3, ",", "\u00a0", "+", "-"},
+	"mk":          {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"mn":          {"¤\u00a00.00", "", 0, 1, 3, 3, ".", ",", "+", "-"},
+	"mr":          {"¤0.00", "¤0.00;(¤0.00)", 4, 1, 3, 3, ".", ",", "+", "-"},
+	"ms-BN":       {"¤\u00a00.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"ms-ID":       {"¤0.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"my":          {"0.00\u00a0¤", "¤\u00a00.00", 5, 1, 3, 3, ".", ",", "+", "-"},
+	"ne":          {"¤\u00a00.00", "", 4, 1, 3, 2, ".", ",", "+", "-"},
+	"nl":          {"¤\u00a00.00;¤\u00a0-0.00", "¤\u00a00.00;(¤\u00a00.00)", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"no":          {"0.00\u00a0¤;-0.00\u00a0¤", "¤\u00a00.00;(¤\u00a00.00)", 0, 1, 3, 3, ",", "\u00a0", "+", "−"},
+	"pa":          {"¤0.00", "¤\u00a00.00", 0, 1, 3, 2, ".", ",", "+", "-"},
+	"pl":          {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 2, 3, 3, ",", "\u00a0", "+", "-"},
+	"ps":          {"¤\u00a00.00", "¤0.00;(¤0.00)", 2, 1, 3, 3, "٫", "٬", "\u200e+\u200e", "\u200e-\u200e"},
+	"pt":          {"¤\u00a00.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"pt-AO":       {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"pt-PT":       {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 2, 3, 3, ",", "\u00a0", "+", "-"},
+	"ro":          {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"ru":          {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"ru-UA":       {"0.00\u00a0¤", "", 0, 2, 3, 3, ",", "\u00a0", "+", "-"},
+	"sk":          {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"sl":          {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 2, 3, 3, ",", ".", "+", "−"},
+	"sq":          {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 2, 3, 3, ",", "\u00a0", "+", "-"},
+	"sr":          {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"sr-Latn":     {"0.00\u00a0¤", "0.00\u00a0¤;(0.00\u00a0¤)", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"sv":          {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "−"},
+	"sw":          {"¤\u00a00.00", "", 0, 1, 3, 3, ".", ",", "+", "-"},
+	"sw-CD":       {"¤\u00a00.00", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"ta":          {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 2, ".", ",", "+", "-"},
+	"ta-MY":       {"¤\u00a00.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ".", ",", "+", "-"},
+	"ta-SG":       {"¤\u00a00.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ".", ",", "+", "-"},
+	"te":          {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 2, ".", ",", "+", "-"},
+	"tk":          {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"tr":          {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ",", ".", "+", "-"},
+	"uk":          {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"ur":          {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	"ur-IN":       {"¤0.00", "¤0.00;(¤0.00)", 2, 1, 3, 3, "٫", "٬", "\u200e+\u200e", "\u200e-\u200e"},
+	"uz":          {"0.00\u00a0¤", "¤0.00;(¤0.00)", 0, 1, 3, 3, ",", "\u00a0", "+", "-"},
+	"vi":          {"0.00\u00a0¤", "", 0, 1, 3, 3, ",", ".", "+", "-"},
+}
+
+var numberingSystemFormats = map[string]map[NumberingSystem]currencyFormat{
+	"ar": {
+		NumArab: {"\u200f0.00\u00a0¤", "", 1, 1, 3, 3, "٫", "٬", "\u061c+", "\u061c-"},
+	},
+	"ar-BH": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	},
+	"ar-DJ": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	},
+	"ar-EG": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	},
+	"ar-ER": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	},
+	"ar-IL": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	},
+	"ar-IQ": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	},
+	"ar-JO": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	},
+	"ar-KM": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	},
+	"ar-KW": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	},
+	"ar-LB": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ",", ".", "\u200e+", "\u200e-"},
+	},
+	"ar-MR": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ",", ".", "\u200e+", "\u200e-"},
+	},
+	"ar-OM": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	},
+	"ar-PS": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	},
+	"ar-QA": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	},
+	"ar-SA": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	},
+	"ar-SD": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	},
+	"ar-SO": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	},
+	"ar-SS": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	},
+	"ar-SY": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	},
+	"ar-TD": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	},
+	"ar-YE": {
+		NumLatn: {"\u200f0.00\u00a0¤;\u200f-0.00\u00a0¤", "\u061c0.00¤;(\u061c0.00¤)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	},
+	"fa": {
+		NumLatn: {"\u200e¤\u00a00.00", "\u200e¤\u00a00.00;\u200e(¤\u00a00.00)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e−"},
+	},
+	"fa-AF": {
+		NumLatn: {"¤\u00a00.00", "¤\u00a00.00;\u200e(¤\u00a00.00)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e−"},
+	},
+	"ps": {
+		NumLatn: {"¤\u00a00.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ",", ".", "\u200e+", "\u200e−"},
+	},
+	"ur": {
+		NumArabExt: {"¤0.00", "¤0.00;(¤0.00)", 2, 1, 3, 3, "٫", "٬", "\u200e+\u200e", "\u200e-\u200e"},
+	},
+	"ur-IN": {
+		NumLatn: {"¤0.00", "¤0.00;(¤0.00)", 0, 1, 3, 3, ".", ",", "\u200e+", "\u200e-"},
+	},
+}
+
+var compactFormats = map[string][]string{
+	"af":         []string{"¤0\u00a0k", "¤00\u00a0k", "¤000\u00a0k", "¤0\u00a0m", "¤00\u00a0m", "¤000\u00a0m", "¤0\u00a0mjd", "¤00\u00a0mjd", "¤000\u00a0mjd", "¤0\u00a0bn", "¤00\u00a0bn", "¤000\u00a0bn"},
+	"am":         []string{"¤0\u00a0ሺ", "¤00\u00a0ሺ", "¤000\u00a0ሺ", "¤0\u00a0ሚ", "¤00\u00a0ሚ", "¤000\u00a0ሚ", "¤0\u00a0ቢ", "¤00\u00a0ቢ", "¤000\u00a0ቢ", "¤0\u00a0ት", "¤00\u00a0ት", "¤000\u00a0ት"},
+	"ar":         []string{"\u200f0\u00a0ألف\u00a0¤", "\u200f00\u00a0ألف\u00a0¤", "\u200f000\u00a0ألف\u00a0¤", "\u200f0\u00a0مليون\u00a0¤", "\u200f00\u00a0مليون\u00a0¤", "\u200f000\u00a0مليون\u00a0¤", "\u200f0\u00a0مليار\u00a0¤", "\u200f00\u00a0مليار\u00a0¤", "\u200f000\u00a0مليار\u00a0¤", "\u200f0\u00a0ترليون\u00a0¤", "\u200f00\u00a0ترليون\u00a0¤", "\u200f000\u00a0ترليون\u00a0¤"},
+	"as":         []string{"¤\u00a00\u00a0হাজাৰ", "¤\u00a000\u00a0হাজাৰ", "¤\u00a00\u00a0লাখ", "¤\u00a00\u00a0নিযুত", "¤\u00a000\u00a0নিযুত", "¤\u00a00\u00a0নিঃ", "¤\u00a00\u00a0শঃ\u00a0কোঃ", "¤\u00a000\u00a0শঃ\u00a0কোঃ", "¤\u00a0000\u00a0শঃ\u00a0কঃ", "¤\u00a00\u00a0শঃ\u00a0পঃ", "¤\u00a000\u00a0শঃ\u00a0পঃ", "¤\u00a0000\u00a0শঃ\u00a0পঃ"},
+	"az":         []string{"0K\u00a0¤", "00K\u00a0¤", "000K\u00a0¤", "0\u00a0mln\u00a0¤", "00\u00a0mln\u00a0¤", "000\u00a0mln\u00a0¤", "0\u00a0mlrd\u00a0¤", "00\u00a0mlrd\u00a0¤", "000\u00a0mlrd\u00a0¤", "0\u00a0trln\u00a0¤", "00\u00a0trln\u00a0¤", "000\u00a0trln\u00a0¤"},
+	"be":         []string{"0\u00a0тыс.\u00a0¤", "00\u00a0тыс.\u00a0¤", "000\u00a0тыс.\u00a0¤", "0\u00a0млн\u00a0¤", "00\u00a0млн\u00a0¤", "000\u00a0млн\u00a0¤", "0\u00a0млрд\u00a0¤", "00\u00a0млрд\u00a0¤", "000\u00a0млрд\u00a0¤", "0\u00a0трлн\u00a0¤", "00\u00a0трлн\u00a0¤", "000\u00a0трлн\u00a0¤"},
+	"bg":         []string{"0\u00a0хил.\u00a0¤", "00\u00a0хил.\u00a0¤", "000\u00a0хил.\u00a0¤", "0\u00a0млн.\u00a0¤", "00\u00a0млн.\u00a0¤", "000\u00a0млн.\u00a0¤", "0\u00a0млрд.\u00a0¤", "00\u00a0млрд.\u00a0¤", "000\u00a0млрд.\u00a0¤", "0\u00a0трлн.\u00a0¤", "00\u00a0трлн.\u00a0¤", "000\u00a0трлн.\u00a0¤"},
+	"bn":         []string{"0\u00a0হা¤", "00\u00a0হা¤", "0\u00a0লা¤", "00\u00a0লা¤", "0\u00a0কো¤", "00\u00a0কো¤", "000\u00a0কো¤", "0শত\u00a0কো¤", "0কো¤", "0\u00a0লা.কো.¤", "00\u00a0লা.কো.¤", "000\u00a0লা.কো.¤"},
+	"bs":         []string{"0\u00a0hilj.\u00a0¤", "00\u00a0hilj.\u00a0¤", "000\u00a0hilj.\u00a0¤", "0\u00a0mil.\u00a0¤", "00\u00a0mil.\u00a0¤", "000\u00a0mil.\u00a0¤", "0\u00a0mlrd.\u00a0¤", "00\u00a0mlrd.\u00a0¤", "000\u00a0mlrd.\u00a0¤", "0\u00a0bil.\u00a0¤", "00\u00a0bil.\u00a0¤", "000\u00a0bil.\u00a0¤"},
+	"ca":         []string{"0\u00a0k\u00a0¤", "00\u00a0k\u00a0¤", "000\u00a0k\u00a0¤", "0\u00a0M\u00a0¤", "00\u00a0M\u00a0¤", "000\u00a0M\u00a0¤", "0000\u00a0M\u00a0¤", "00\u00a0kM\u00a0¤", "000\u00a0kM\u00a0¤", "0\u00a0B\u00a0¤", "00\u00a0B\u00a0¤", "000\u00a0B\u00a0¤"},
+	"cs":         []string{"0\u00a0tis.\u00a0¤", "00\u00a0tis.\u00a0¤", "000\u00a0tis.\u00a0¤", "0\u00a0mil.\u00a0¤", "00\u00a0mil.\u00a0¤", "000\u00a0mil.\u00a0¤", "0\u00a0mld.\u00a0¤", "00\u00a0mld.\u00a0¤", "000\u00a0mld.\u00a0¤", "0\u00a0bil.\u00a0¤", "00\u00a0bil.\u00a0¤", "000\u00a0bil.\u00a0¤"},
+	"da":         []string{"0\u00a0t\u00a0¤", "00\u00a0t\u00a0¤", "000\u00a0t\u00a0¤", "0\u00a0mio.\u00a0¤", "00\u00a0mio.\u00a0¤", "000\u00a0mio.\u00a0¤", "0\u00a0mia.\u00a0¤", "00\u00a0mia.\u00a0¤", "000\u00a0mia.\u00a0¤", "0\u00a0bio.\u00a0¤", "00\u00a0bio.\u00a0¤", "000\u00a0bio.\u00a0¤"},
+	"de":         []string{"0", "0", "0", "0\u00a0Mio.\u00a0¤", "00\u00a0Mio.\u00a0¤", "000\u00a0Mio.\u00a0¤", "0\u00a0Mrd.\u00a0¤", "00\u00a0Mrd.\u00a0¤", "000\u00a0Mrd.\u00a0¤", "0\u00a0Bio.\u00a0¤", "00\u00a0Bio.\u00a0¤", "000\u00a0Bio.\u00a0¤"},
+	"de-CH":      []string{"0", "0", "0", "¤\u00a00\u00a0Mio.", "¤\u00a000\u00a0Mio.", "¤\u00a0000\u00a0Mio.", "¤\u00a00\u00a0Mrd.", "¤\u00a000\u00a0Mrd.", "¤\u00a0000\u00a0Mrd.", "¤\u00a00\u00a0Bio.", "¤\u00a000\u00a0Bio.", "¤\u00a0000\u00a0Bio."},
+	"dsb":        []string{"0\u00a0tys.\u00a0¤", "00\u00a0tys.\u00a0¤", "000\u00a0tys.\u00a0¤", "0\u00a0mio.\u00a0¤", "00\u00a0mio.\u00a0¤", "000\u00a0mio.\u00a0¤", "0\u00a0mrd.\u00a0¤", "00\u00a0mrd.\u00a0¤", "000\u00a0mrd.\u00a0¤", "0\u00a0bil.\u00a0¤", "00\u00a0bil.\u00a0¤", "000\u00a0bil.\u00a0¤"},
+	"el":         []string{"0\u00a0χιλ.\u00a0¤", "00\u00a0χιλ.\u00a0¤", "000\u00a0χιλ.\u00a0¤", "0\u00a0εκ.\u00a0¤", "00\u00a0εκ.\u00a0¤", "000\u00a0εκ.\u00a0¤", "0\u00a0δισ.\u00a0¤", "00\u00a0δισ.\u00a0¤", "000\u00a0δισ.\u00a0¤", "0\u00a0τρισ.\u00a0¤", "00\u00a0τρισ.\u00a0¤", "000\u00a0τρισ.\u00a0¤"},
+	"en":         []string{"¤0K", "¤00K", "¤000K", "¤0M", "¤00M", "¤000M", "¤0B", "¤00B", "¤000B", "¤0T", "¤00T", "¤000T"},
+	"en-GB":      []string{"¤0k", "¤00k", "¤000k", "¤0m", "¤00m", "¤000m", "¤0bn", "¤00bn", "¤000bn", "¤0tn", "¤00tn", "¤000tn"},
+	"en-IN":      []string{"¤0K", "¤00K", "¤0L", "¤00L", "¤0Cr", "¤00Cr", "¤000Cr", "¤0KCr", "¤00KCr", "¤0LCr", "¤00LCr", "¤000LCr"},
+	"es":         []string{"0\u00a0mil\u00a0¤", "00\u00a0mil\u00a0¤", "000\u00a0mil\u00a0¤", "0\u00a0M\u00a0¤", "00\u00a0M\u00a0¤", "000\u00a0M\u00a0¤", "0000\u00a0M\u00a0¤", "00\u00a0mil\u00a0M\u00a0¤", "000\u00a0mil\u00a0M\u00a0¤", "0\u00a0B\u00a0¤", "00\u00a0B\u00a0¤", "000\u00a0B\u00a0¤"},
+	"es-419":     []string{"¤0\u00a0K", "¤00\u00a0k", "¤000\u00a0k", "¤0\u00a0M", "¤00\u00a0M", "¤000\u00a0M", "¤0000\u00a0M", "¤00\u00a0mil\u00a0M", "¤000\u00a0mil\u00a0M", "¤0\u00a0B", "¤00\u00a0B", "¤000\u00a0B"},
+	"es-GT":      []string{"¤0K", "¤00K", "¤000K", "¤0M", "¤00M", "¤000M", "¤0000M", "¤00MRD", "¤000MRD", "¤0\u00a0B", "¤00\u00a0B", "¤000\u00a0B"},
+	"es-MX":      []string{"¤0\u00a0k", "¤00\u00a0k", "¤000\u00a0k", "¤0\u00a0M", "¤00\u00a0M", "¤000\u00a0M", "¤0000\u00a0M", "¤00\u00a0mil\u00a0M", "¤000\u00a0mil\u00a0M", "¤0\u00a0B", "¤00\u00a0B", "¤000\u00a0B"},
+	"es-PE":      []string{"¤\u00a00\u00a0K", "¤\u00a000\u00a0K", "¤\u00a0000\u00a0K", "¤\u00a00\u00a0M", "¤\u00a000\u00a0M", "¤\u00a0000\u00a0M", "¤\u00a00000\u00a0M", "¤\u00a000\u00a0MRD", "¤\u00a0000\u00a0MRD", "¤\u00a00\u00a0B", "¤\u00a000\u00a0B", "¤\u00a0000\u00a0B"},
+	"es-US":      []string{"¤0\u00a0K", "¤00\u00a0K", "¤000\u00a0K", "¤0\u00a0M", "¤00\u00a0M", "¤000\u00a0M", "¤0000\u00a0M", "¤00\u00a0mil\u00a0M", "¤000\u00a0mil\u00a0M", "¤0\u00a0B", "¤00\u00a0B", "¤000\u00a0B"},
+	"et":         []string{"0\u00a0tuh\u00a0¤", "00\u00a0tuh\u00a0¤", "000\u00a0tuh\u00a0¤", "0\u00a0mln\u00a0¤", "00\u00a0mln\u00a0¤", "000\u00a0mln\u00a0¤", "0\u00a0mld\u00a0¤", "00\u00a0mld\u00a0¤", "000\u00a0mld\u00a0¤", "0\u00a0trln\u00a0¤", "00\u00a0trln\u00a0¤", "000\u00a0trln\u00a0¤"},
+	"eu":         []string{"0", "0", "0", "0\u00a0M\u00a0¤", "00\u00a0M\u00a0¤", "000\u00a0M\u00a0¤", "0000\u00a0M\u00a0¤", "00000\u00a0M\u00a0¤", "000000\u00a0M\u00a0¤", "0\u00a0B\u00a0¤", "00\u00a0B\u00a0¤", "000\u00a0B\u00a0¤"},
+	"fa":         []string{"\u200e¤\u00a00\u00a0هزار", "\u200e¤\u00a000\u00a0هزار", "\u200e¤\u00a0000\u00a0هزار", "\u200e¤\u00a00\u00a0میلیون", "\u200e¤\u00a000\u00a0میلیون", "\u200e¤\u00a0000\u00a0میلیون", "\u200e¤\u00a00\u00a0میلیارد", "\u200e¤\u00a000\u00a0میلیارد", "\u200e¤\u00a0000\u00a0میلیارد", "\u200e¤\u00a00\u00a0تریلیون", "\u200e¤\u00a000\u00a0تریلیون", "\u200e¤\u00a0000\u00a0تریلیون"},
+	"fi":         []string{"0\u00a0t.\u00a0¤", "00\u00a0t.\u00a0¤", "000\u00a0t.\u00a0¤", "0\u00a0milj.\u00a0¤", "00\u00a0milj.\u00a0¤", "000\u00a0milj.\u00a0¤", "0\u00a0mrd.\u00a0¤", "00\u00a0mrd.\u00a0¤", "000\u00a0mrd.\u00a0¤", "0\u00a0bilj.\u00a0¤", "00\u00a0bilj.\u00a0¤", "000\u00a0bilj.\u00a0¤"},
+	"fr":         []string{"0\u00a0k\u00a0¤", "00\u00a0k\u00a0¤", "000\u00a0k\u00a0¤", "0\u00a0M\u00a0¤", "00\u00a0M\u00a0¤", "000\u00a0M\u00a0¤", "0\u00a0Md\u00a0¤", "00\u00a0Md\u00a0¤", "000\u00a0Md\u00a0¤", "0\u00a0Bn\u00a0¤", "00\u00a0Bn\u00a0¤", "000\u00a0Bn\u00a0¤"},
+	"fr-CA":      []string{"0\u00a0k\u00a0¤", "00\u00a0k\u00a0¤", "000\u00a0k\u00a0¤", "0\u00a0M\u00a0¤", "00\u00a0M\u00a0¤", "000\u00a0M\u00a0¤", "0\u00a0G\u00a0¤", "00\u00a0G\u00a0¤", "000\u00a0G\u00a0¤", "0\u00a0T\u00a0¤", "00\u00a0T\u00a0¤", "000\u00a0T\u00a0¤"},
+	"ga":         []string{"¤0k", "¤00k", "¤000k", "¤0M", "¤00M", "¤000M", "¤0B", "¤00B", "¤000B", "¤0T", "¤00T", "¤000T"},
+	"gl":         []string{"0", "0", "0", "0\u00a0M\u00a0¤", "00\u00a0M\u00a0¤", "000\u00a0M\u00a0¤", "0000\u00a0M\u00a0¤", "00000\u00a0M\u00a0¤", "000000\u00a0M\u00a0¤", "0\u00a0B\u00a0¤", "00\u00a0B\u00a0¤", "000\u00a0B\u00a0¤"},
+	"gu":         []string{"¤0\u00a0હજાર", "¤00\u00a0હજાર", "¤0\u00a0લાખ", "¤00\u00a0લાખ", "¤0\u00a0કરોડ", "¤00\u00a0કરોડ", "¤0\u00a0અબજ", "¤00\u00a0અબજ", "¤0\u00a0નિખર્વ", "¤0\u00a0મહાપદ્મ", "¤0\u00a0શંકુ", "¤0\u00a0જલધિ"},
+	"he":         []string{"\u200f0K\u200f\u00a0\u200f¤", "\u200f00K\u200f\u00a0\u200f¤", "\u200f000K\u200f\u00a0\u200f¤", "\u200f0M\u200f\u00a0\u200f¤", "\u200f00M\u200f\u00a0\u200f¤", "\u200f000M\u200f\u00a0\u200f¤", "\u200f0B\u200f\u00a0\u200f¤", "\u200f00B\u200f\u00a0\u200f¤", "\u200f000B\u200f\u00a0\u200f¤", "\u200f0T\u200f\u00a0\u200f¤", "\u200f00T\u200f\u00a0\u200f¤", "\u200f000T\u200f\u00a0\u200f¤"},
+	"hi":         []string{"¤0\u00a0हज़ार", "¤00\u00a0हज़ार", "¤0\u00a0लाख", "¤00\u00a0लाख", "¤0\u00a0क॰", "¤00\u00a0क॰", "¤0\u00a0अ॰", "¤00\u00a0अ॰", "¤0\u00a0ख॰", "¤00\u00a0ख॰", "¤0\u00a0नील", "¤00\u00a0नील"},
+	"hr":         []string{"0\u00a0tis.\u00a0¤", "00\u00a0tis.\u00a0¤", "000\u00a0tis.\u00a0¤", "0\u00a0mil.\u00a0¤", "00\u00a0mil.\u00a0¤", "000\u00a0mil.\u00a0¤", "0\u00a0mlr.\u00a0¤", "00\u00a0mlr.\u00a0¤", "000\u00a0mlr.\u00a0¤", "0\u00a0bil.\u00a0¤", "00\u00a0bil.\u00a0¤", "000\u00a0bil.\u00a0¤"},
+	"hsb":        []string{"0\u00a0tys.\u00a0¤", "00\u00a0tys.\u00a0¤", "000\u00a0tys.\u00a0¤", "0\u00a0mio.\u00a0¤", "00\u00a0mio.\u00a0¤", "000\u00a0mio.\u00a0¤", "0\u00a0mrd.\u00a0¤", "00\u00a0mrd.\u00a0¤", "000\u00a0mrd.\u00a0¤", "0\u00a0bil.\u00a0¤", "00\u00a0bil.\u00a0¤", "000\u00a0bil.\u00a0¤"},
+	"hu":         []string{"0\u00a0E\u00a0¤", "00\u00a0E\u00a0¤", "000\u00a0E\u00a0¤", "0\u00a0M\u00a0¤", "00\u00a0M\u00a0¤", "000\u00a0M\u00a0¤", "0\u00a0Mrd\u00a0¤", "00\u00a0Mrd\u00a0¤", "000\u00a0Mrd\u00a0¤", "0\u00a0B\u00a0¤", "00\u00a0B\u00a0¤", "000\u00a0B\u00a0¤"},
+	"hy":         []string{"0\u00a0հզր\u00a0¤", "00\u00a0հզր\u00a0¤", "000\u00a0հզր\u00a0¤", "0\u00a0մլն\u00a0¤", "00\u00a0մլն\u00a0¤", "000\u00a0մլն\u00a0¤", "0\u00a0մլրդ\u00a0¤", "00\u00a0մլրդ\u00a0¤", "000\u00a0մլրդ\u00a0¤", "0\u00a0տրլն\u00a0¤", "00\u00a0տրլն\u00a0¤", "000\u00a0տրլն\u00a0¤"},
+	"id":         []string{"¤0\u00a0rb", "¤00\u00a0rb", "¤000\u00a0rb", "¤0\u00a0jt", "¤00\u00a0jt", "¤000\u00a0jt", "¤0\u00a0M", "¤00\u00a0M", "¤000\u00a0M", "¤0\u00a0T", "¤00\u00a0T", "¤000\u00a0T"},
+	"ig":         []string{"¤0K", "¤00K", "¤000K", "¤0M", "¤00M", "¤000M", "¤0G", "¤00G", "¤000G", "¤0T", "¤00T", "¤000T"},
+	"is":         []string{"0\u00a0þ.\u00a0¤", "00\u00a0þ.\u00a0¤", "000\u00a0þ.\u00a0¤", "0\u00a0m.\u00a0¤", "00\u00a0m.\u00a0¤", "000\u00a0m.\u00a0¤", "0\u00a0ma.\u00a0¤", "00\u00a0ma.\u00a0¤", "000\u00a0ma.\u00a0¤", "0\u00a0bn\u00a0¤", "00\u00a0bn\u00a0¤", "000\u00a0bn\u00a0¤"},
+	"it":         []string{"0K\u00a0¤", "00K\u00a0¤", "000K\u00a0¤", "0\u00a0Mln\u00a0¤", "00\u00a0Mln\u00a0¤", "000\u00a0Mln\u00a0¤", "0\u00a0Mld\u00a0¤", "00\u00a0Mld\u00a0¤", "000\u00a0Mld\u00a0¤", "0\u00a0Bln\u00a0¤", "00\u00a0Bln\u00a0¤", "000\u00a0Bln\u00a0¤"},
+	"ja":         []string{"0", "¤0万", "¤00万", "¤000万", "¤0000万", "¤0億", "¤00億", "¤000億", "¤0000億", "¤0兆", "¤00兆", "¤000兆"},
+	"ka":         []string{"0\u00a0ათ.\u00a0¤", "00\u00a0ათ.\u00a0¤", "000\u00a0ათ.\u00a0¤", "0\u00a0მლნ.\u00a0¤", "00\u00a0მლნ.\u00a0¤", "000\u00a0მლნ.\u00a0¤", "0\u00a0მლრდ.\u00a0¤", "00\u00a0მლრდ.\u00a0¤", "000\u00a0მლრ.\u00a0¤", "0\u00a0ტრლ.\u00a0¤", "00\u00a0ტრლ.\u00a0¤", "000\u00a0ტრლ.\u00a0¤"},
+	"kk":         []string{"0\u00a0мың\u00a0¤", "00\u00a0мың\u00a0¤", "000\u00a0м.\u00a0¤", "0\u00a0млн\u00a0¤", "00\u00a0млн\u00a0¤", "000\u00a0млн\u00a0¤", "0\u00a0млрд\u00a0¤", "00\u00a0млрд\u00a0¤", "000\u00a0млрд\u00a0¤", "0\u00a0трлн\u00a0¤", "00\u00a0трлн\u00a0¤", "000\u00a0трлн\u00a0¤"},
+	"km":         []string{"0ពាន់¤", "00\u00a0ពាន់¤", "000\u00a0ពាន់¤", "0\u00a0លាន¤", "00\u00a0លាន¤", "000\u00a0លាន¤", "0\u00a0ប៊ីលាន¤", "00\u00a0ប៊ីលាន¤", "000\u00a0ប៊ីលាន¤", "0\u00a0ទ្រីលាន¤", "00\u00a0ទ្រីលាន¤", "000\u00a0ទ្រីលាន¤"},
+	"ko":         []string{"¤0천", "¤0만", "¤00만", "¤000만", "¤0000만", "¤0억", "¤00억", "¤000억", "¤0000억", "¤0조", "¤00조", "¤000조"},
+	"kok":        []string{"¤0हज", "¤00हज", "¤0लाख", "¤00लाख", "¤0कोटी", "¤00कोटी", "¤0अब्ज", "¤00अब्ज", "¤0निख", "¤00निख", "¤000निख", "¤0हज.निख."},
+	"kok-Latn":   []string{"¤0hoz", "¤00hoz", "¤0lak", "¤00lak", "¤0ko", "¤00ko", "¤0obz", "¤00obz", "¤0nikh", "¤00nikh", "¤000nikh", "¤0hoz.nikh."},
+	"ky":         []string{"0\u00a0миң\u00a0¤", "00\u00a0миң\u00a0¤", "000\u00a0миң\u00a0¤", "0\u00a0млн\u00a0¤", "00\u00a0млн\u00a0¤", "000\u00a0млн\u00a0¤", "0\u00a0млд\u00a0¤", "00\u00a0млд\u00a0¤", "000\u00a0млд\u00a0¤", "0\u00a0трлн\u00a0¤", "00\u00a0трлн\u00a0¤", "000\u00a0трлн\u00a0¤"},
+	"lo":         []string{"¤0\u00a0ພັນ", "¤00\u00a0ພັນ", "¤000\u00a0ພັນ", "¤0\u00a0ລ້ານ", "¤00\u00a0ລ້ານ", "¤000\u00a0ລ້ານ", "¤0\u00a0ຕື້", "¤00\u00a0ຕື້", "¤000\u00a0ຕື້", "¤0\u00a0ລ້ານລ້ານ", "¤00ລລ", "¤000ລລ"},
+	"lt":         []string{"0\u00a0tūkst.\u00a0¤", "00\u00a0tūkst.\u00a0¤", "000\u00a0tūkst.\u00a0¤", "0\u00a0mln.\u00a0¤", "00\u00a0mln.\u00a0¤", "000\u00a0mln.\u00a0¤", "0\u00a0mlrd.\u00a0¤", "00\u00a0mlrd.\u00a0¤", "000\u00a0mlrd.\u00a0¤", "0\u00a0trln.\u00a0¤", "00\u00a0trln.\u00a0¤", "000\u00a0trln.\u00a0¤"},
+	"lv":         []string{"0\u00a0tūkst.\u00a0¤", "00\u00a0tūkst.\u00a0¤", "000\u00a0tūkst.\u00a0¤", "0\u00a0milj.\u00a0¤", "00\u00a0milj.\u00a0¤", "000\u00a0milj.\u00a0¤", "0\u00a0mljrd.\u00a0¤", "00\u00a0mljrd.\u00a0¤", "000\u00a0mljrd.\u00a0¤", "0\u00a0trilj.\u00a0¤", "00\u00a0trilj.\u00a0¤", "000\u00a0trilj.\u00a0¤"},
+	"mk":         []string{"0\u00a0илј.\u00a0¤", "00\u00a0илј.\u00a0¤", "000\u00a0илј.\u00a0¤", "0\u00a0мил.\u00a0¤", "00\u00a0мил.\u00a0¤", "000\u00a0М\u00a0¤", "0\u00a0милј.\u00a0¤", "00\u00a0милј.\u00a0¤", "000\u00a0ми.\u00a0¤", "0\u00a0бил.\u00a0¤", "00\u00a0бил.\u00a0¤", "000\u00a0бил.\u00a0¤"},
+	"mn":         []string{"¤\u00a00\u00a0мян", "¤\u00a000\u00a0мян", "¤\u00a0000\u00a0мян", "¤\u00a00\u00a0сая", "¤\u00a000\u00a0сая", "¤\u00a0000\u00a0сая", "¤\u00a00\u00a0тэрбум", "¤\u00a000\u00a0тэрбум", "¤\u00a0000Т", "¤\u00a00ИН", "¤\u00a000ИН", "¤\u00a0000ИН"},
+	"mr":         []string{"¤0\u00a0ह", "¤00\u00a0ह", "¤0\u00a0लाख", "¤00\u00a0लाख", "¤0\u00a0कोटी", "¤00\u00a0कोटी", "¤0\u00a0अब्ज", "¤00\u00a0अब्ज", "¤0\u00a0खर्व", "¤00\u00a0खर्व", "¤0\u00a0पद्म", "¤00\u00a0पद्म"},
+	"ms":         []string{"¤0K", "¤00K", "¤000K", "¤0J", "¤00J", "¤000J", "¤0B", "¤00B", "¤000B", "¤0T", "¤00T", "¤000T"},
+	"my":         []string{"0\u00a0ထောင်\u00a0¤", "0\u00a0သောင်း\u00a0¤", "0\u00a0သိန်း\u00a0¤", "0\u00a0သန်း\u00a0¤", "0\u00a0ကုဋေ\u00a0¤", "00\u00a0ကုဋေ\u00a0¤", "000\u00a0ဋေ\u00a0¤", "ဋေ\u00a00\u00a0ထ\u00a0¤", "ဋေ\u00a00\u00a0သ\u00a0¤", "ဋေ\u00a00\u00a0သိန်း\u00a0¤", "ဋေ\u00a00\u00a0သန်း\u00a0¤", "0\u00a0ကောဋိ\u00a0¤"},
+	"ne":         []string{"¤\u00a00\u00a0हजार", "¤\u00a000\u00a0हजार", "¤\u00a00\u00a0लाख", "¤\u00a000\u00a0लाख", "¤\u00a00\u00a0करोड", "¤\u00a000\u00a0करोड", "¤\u00a00\u00a0अरब", "¤\u00a000\u00a0अरब", "¤\u00a00\u00a0खरब", "¤\u00a000\u00a0खरब", "¤\u00a00\u00a0शंख", "¤\u00a000\u00a0शंख"},
+	"nl":         []string{"¤\u00a00K", "¤\u00a000K", "¤\u00a0000K", "¤\u00a00\u00a0mln.", "¤\u00a000\u00a0mln.", "¤\u00a0000\u00a0mln.", "¤\u00a00\u00a0mld.", "¤\u00a000\u00a0mld.", "¤\u00a0000\u00a0mld.", "¤\u00a00\u00a0bln.", "¤\u00a000\u00a0bln.", "¤\u00a0000\u00a0bln."},
+	"no":         []string{"0k\u00a0¤", "00k\u00a0¤", "000k\u00a0¤", "0\u00a0mill.\u00a0¤", "00\u00a0mill.\u00a0¤", "000\u00a0mill.\u00a0¤", "0\u00a0mrd.\u00a0¤", "00\u00a0mrd.\u00a0¤", "000\u00a0mrd.\u00a0¤", "0\u00a0bill.\u00a0¤", "00\u00a0bill.\u00a0¤", "000\u00a0bill.\u00a0¤"},
+	"pa":         []string{"¤0\u00a0ਹਜ਼ਾਰ", "¤00\u00a0ਹਜ਼ਾਰ", "¤0\u00a0ਲੱਖ", "¤00\u00a0ਲੱਖ", "¤0\u00a0ਕਰੋੜ", "¤00\u00a0ਕਰੋੜ", "¤0\u00a0ਅਰਬ", "¤00\u00a0ਅਰਬ", "¤0\u00a0ਖਰਬ", "¤00\u00a0ਖਰਬ", "¤0\u00a0ਨੀਲ", "¤00\u00a0ਨੀਲ"},
+	"pl":         []string{"0\u00a0tys.\u00a0¤", "00\u00a0tys.\u00a0¤", "000\u00a0tys.\u00a0¤", "0\u00a0mln\u00a0¤", "00\u00a0mln\u00a0¤", "000\u00a0mln\u00a0¤", "0\u00a0mld\u00a0¤", "00\u00a0mld\u00a0¤", "000\u00a0mld\u00a0¤", "0\u00a0bln\u00a0¤", "00\u00a0bln\u00a0¤", "000\u00a0bln\u00a0¤"},
+	"ps":         []string{"¤\u00a00K", "¤\u00a000K", "¤\u00a0000K", "¤\u00a00M", "¤\u00a000M", "¤\u00a0000M", "¤\u00a00B", "¤\u00a000B", "¤\u00a0000B", "¤\u00a00T", "¤\u00a000T", "¤\u00a0000T"},
+	"pt":         []string{"¤\u00a00\u00a0mil", "¤\u00a000\u00a0mil", "¤\u00a0000\u00a0mil", "¤\u00a00\u00a0mi", "¤\u00a000\u00a0mi", "¤\u00a0000\u00a0mi", "¤\u00a00\u00a0bi", "¤\u00a000\u00a0bi", "¤\u00a0000\u00a0bi", "¤\u00a00\u00a0tri", "¤\u00a000\u00a0tri", "¤\u00a0000\u00a0tri"},
+	"pt-PT":      []string{"0\u00a0mil\u00a0¤", "00\u00a0mil\u00a0¤", "000\u00a0mil\u00a0¤", "0\u00a0M\u00a0¤", "00\u00a0M\u00a0¤", "000\u00a0M\u00a0¤", "0\u00a0mM\u00a0¤", "00\u00a0mM\u00a0¤", "000\u00a0mM\u00a0¤", "0\u00a0Bi\u00a0¤", "00\u00a0Bi\u00a0¤", "000\u00a0Bi\u00a0¤"},
+	"ro":         []string{"0\u00a0K\u00a0¤", "00\u00a0K\u00a0¤", "000\u00a0K\u00a0¤", "0\u00a0mil.\u00a0¤", "00\u00a0mil.\u00a0¤", "000\u00a0mil.\u00a0¤", "0\u00a0mld.\u00a0¤", "00\u00a0mld.\u00a0¤", "000\u00a0mld.\u00a0¤", "0\u00a0tril.\u00a0¤", "00\u00a0tril.\u00a0¤", "000\u00a0tril.\u00a0¤"},
+	"ru":         []string{"0\u00a0тыс.\u00a0¤", "00\u00a0тыс.\u00a0¤", "000\u00a0тыс.\u00a0¤", "0\u00a0млн\u00a0¤", "00\u00a0млн\u00a0¤", "000\u00a0млн\u00a0¤", "0\u00a0млрд\u00a0¤", "00\u00a0млрд\u00a0¤", "000\u00a0млрд\u00a0¤", "0\u00a0трлн\u00a0¤", "00\u00a0трлн\u00a0¤", "000\u00a0трлн\u00a0¤"},
+	"si":         []string{"¤ද0", "¤ද00", "¤ද000", "¤මි0", "¤මි00", "¤මි000", "¤බි0", "¤බි00", "¤බි000", "¤ට්\u200dරි0", "¤ට්\u200dරි00", "¤ට්\u200dරි000"},
+	"sk":         []string{"0\u00a0tis.\u00a0¤", "00\u00a0tis.\u00a0¤", "000\u00a0tis.\u00a0¤", "0\u00a0mil.\u00a0¤", "00\u00a0mil.\u00a0¤", "000\u00a0mil.\u00a0¤", "0\u00a0mld.\u00a0¤", "00\u00a0mld.\u00a0¤", "000\u00a0mld.\u00a0¤", "0\u00a0bil.\u00a0¤", "00\u00a0bil.\u00a0¤", "000\u00a0bil.\u00a0¤"},
+	"sl":         []string{"0\u00a0tis.\u00a0¤", "00\u00a0tis.\u00a0¤", "000\u00a0tis.\u00a0¤", "0\u00a0mio.\u00a0¤", "00\u00a0mio.\u00a0¤", "000\u00a0mio.\u00a0¤", "0\u00a0mrd.\u00a0¤", "00\u00a0mrd.\u00a0¤", "000\u00a0mrd.\u00a0¤", "0\u00a0bil.\u00a0¤", "00\u00a0bil.\u00a0¤", "000\u00a0bil.\u00a0¤"},
+	"sq":         []string{"0\u00a0mijë\u00a0¤", "00\u00a0mijë\u00a0¤", "000\u00a0mijë\u00a0¤", "0\u00a0mln\u00a0¤", "00\u00a0mln\u00a0¤", "000\u00a0mln\u00a0¤", "0\u00a0mld\u00a0¤", "00\u00a0mld\u00a0¤", "000\u00a0mld\u00a0¤", "0\u00a0bln\u00a0¤", "00\u00a0bln\u00a0¤", "000\u00a0bln\u00a0¤"},
+	"sr":         []string{"0\u00a0хиљ.\u00a0¤", "00\u00a0хиљ.\u00a0¤", "000\u00a0хиљ.\u00a0¤", "0\u00a0мил.\u00a0¤", "00\u00a0мил.\u00a0¤", "000\u00a0мил.\u00a0¤", "0\u00a0млрд.\u00a0¤", "00\u00a0млрд.\u00a0¤", "000\u00a0млрд.\u00a0¤", "0\u00a0бил.\u00a0¤", "00\u00a0бил.\u00a0¤", "000\u00a0бил.\u00a0¤"},
+	"sr-Latn":    []string{"0\u00a0hilj.\u00a0¤", "00\u00a0hilj.\u00a0¤", "000\u00a0hilj.\u00a0¤", "0\u00a0mil.\u00a0¤", "00\u00a0mil.\u00a0¤", "000\u00a0mil.\u00a0¤", "0\u00a0mlrd.\u00a0¤", "00\u00a0mlrd.\u00a0¤", "000\u00a0mlrd.\u00a0¤", "0\u00a0bil.\u00a0¤", "00\u00a0bil.\u00a0¤", "000\u00a0bil.\u00a0¤"},
+	"sv":         []string{"0\u00a0tn\u00a0¤", "00\u00a0tn\u00a0¤", "000\u00a0tn\u00a0¤", "0\u00a0mn\u00a0¤", "00\u00a0mn\u00a0¤", "000\u00a0mn\u00a0¤", "0\u00a0md\u00a0¤", "00\u00a0md\u00a0¤", "000\u00a0md\u00a0¤", "0\u00a0bn\u00a0¤", "00\u00a0bn\u00a0¤", "000\u00a0bn\u00a0¤"},
+	"sw":         []string{"¤\u00a0elfu\u00a00", "¤\u00a0elfu\u00a000", "¤\u00a0elfu\u00a0000", "¤\u00a00M", "¤\u00a000M", "¤\u00a0000M", "¤\u00a00B", "¤\u00a000B", "¤\u00a0000B", "¤\u00a00T", "¤\u00a000T", "¤\u00a0000T"},
+	"sw-KE":      []string{"¤\u00a0elfu\u00a00", "¤\u00a0elfu\u00a000", "¤\u00a0elfu\u00a0000", "¤\u00a0M0", "¤\u00a0M00", "¤\u00a0M000", "¤\u00a0B0", "¤\u00a0B00", "¤\u00a0B000", "¤\u00a0T0", "¤\u00a0T00", "¤\u00a0T000"},
+	"ta":         []string{"¤0ஆ", "¤00ஆ", "¤000ஆ", "¤0மி", "¤00மி", "¤000மி", "¤0பி", "¤00பி", "¤000பி", "¤0டி", "¤00டி", "¤000டி"},
+	"te":         []string{"¤0వే", "¤00వే", "¤000వే", "¤0మి", "¤00మి", "¤000మి", "¤0బి", "¤00బి", "¤000బి", "¤0ట్రి", "¤00ట్రి", "¤000ట్రి"},
+	"tk":         []string{"0\u00a0müň\u00a0¤", "00\u00a0müň\u00a0¤", "000\u00a0müň\u00a0¤", "0\u00a0mln\u00a0¤", "00\u00a0mln\u00a0¤", "000\u00a0mln\u00a0¤", "0\u00a0mlrd\u00a0¤", "00\u00a0mlrd\u00a0¤", "000\u00a0mlrd\u00a0¤", "0\u00a0trln\u00a0¤", "00\u00a0trln\u00a0¤", "000\u00a0trln\u00a0¤"},
+	"tr":         []string{"¤0\u00a0B", "¤00\u00a0B", "¤000\u00a0B", "¤0\u00a0Mn", "¤00\u00a0Mn", "¤000\u00a0Mn", "¤0\u00a0Mr", "¤00\u00a0Mr", "¤000\u00a0Mr", "¤0\u00a0Tn", "¤00\u00a0Tn", "¤000\u00a0Tn"},
+	"uk":         []string{"0\u00a0тис.\u00a0¤", "00\u00a0тис.\u00a0¤", "000\u00a0тис.\u00a0¤", "0\u00a0млн\u00a0¤", "00\u00a0млн\u00a0¤", "000\u00a0млн\u00a0¤", "0\u00a0млрд\u00a0¤", "00\u00a0млрд\u00a0¤", "000\u00a0млрд\u00a0¤", "0\u00a0трлн\u00a0¤", "00\u00a0трлн\u00a0¤", "000\u00a0трлн\u00a0¤"},
+	"ur":         []string{"¤0\u00a0ہزار", "¤00\u00a0ہزار", "¤0\u00a0لاکھ", "¤00\u00a0لاکھ", "¤0\u00a0کروڑ", "¤00\u00a0کروڑ", "¤0\u00a0ارب", "¤00\u00a0ارب", "¤0\u00a0کھرب", "¤00\u00a0کھرب", "¤00\u00a0ٹریلین", "¤000\u00a0ٹریلین"},
+	"uz":         []string{"0\u00a0ming\u00a0¤", "00\u00a0ming\u00a0¤", "000\u00a0ming\u00a0¤", "0\u00a0mln\u00a0¤", "00\u00a0mln\u00a0¤", "000\u00a0mln\u00a0¤", "0\u00a0mlrd\u00a0¤", "00\u00a0mlrd\u00a0¤", "000\u00a0mlrd\u00a0¤", "0\u00a0trln\u00a0¤", "00\u00a0trln\u00a0¤", "000\u00a0trln\u00a0¤"},
+	"vi":         []string{"0\u00a0N\u00a0¤", "00\u00a0N\u00a0¤", "000\u00a0N\u00a0¤", "0\u00a0Tr\u00a0¤", "00\u00a0Tr\u00a0¤", "000\u00a0Tr\u00a0¤", "0\u00a0T\u00a0¤", "00\u00a0T\u00a0¤", "000\u00a0T\u00a0¤", "0\u00a0NT\u00a0¤", "00\u00a0NT\u00a0¤", "000\u00a0NT\u00a0¤"},
+	"yue":        []string{"¤0千", "¤0萬", "¤00萬", "¤000萬", "¤0000萬", "¤0億", "¤00億", "¤000億", "¤0000億", "¤0兆", "¤00兆", "¤000兆"},
+	"yue-Hans":   []string{"¤0千", "¤0万", "¤00万", "¤000万", "¤0000万", "¤0亿", "¤00亿", "¤000亿", "¤0000亿", "¤0兆", "¤00兆", "¤000兆"},
+	"zh":         []string{"0", "¤0万", "¤00万", "¤000万", "¤0000万", "¤0亿", "¤00亿", "¤000亿", "¤0000亿", "¤0万亿", "¤00万亿", "¤000万亿"},
+	"zh-Hant":    []string{"0", "¤0萬", "¤00萬", "¤000萬", "¤0000萬", "¤0億", "¤00億", "¤000億", "¤0000億", "¤0兆", "¤00兆", "¤000兆"},
+	"zh-Hant-HK": []string{"¤0K", "¤00K", "¤000K", "¤0M", "¤00M", "¤000M", "¤0B", "¤00B", "¤000B", "¤0T", "¤00T", "¤000T"},
+}
+
+var rangeFormats = map[string]rangeFormat{
+	"be":       {"{0}–{1}", "≈{0}"},
+	"bg":       {"{0} – {1}", "~{0}"},
+	"bs":       {"{0} – {1}", "~{0}"},
+	"ca":       {"{0}-{1}", "~{0}"},
+	"da":       {"{0}-{1}", "~{0}"},
+	"de":       {"{0}–{1}", "≈{0}"},
+	"dsb":      {"{0}–{1}", "≈{0}"},
+	"en":       {"{0}–{1}", "~{0}"},
+	"es":       {"{0}-{1}", "~{0}"},
+	"et":       {"{0}‒{1}", "~ {0}"},
+	"eu":       {"{0}-{1}", "~{0}"},
+	"fil":      {"{0}-{1}", "~{0}"},
+	"fr":       {"{0}–{1}", "≈{0}"},
+	"gu":       {"{0}-{1}", "~{0}"},
+	"hr":       {"{0} – {1}", "~{0}"},
+	"hsb":      {"{0}–{1}", "≈{0}"},
+	"it":       {"{0}-{1}", "~{0}"},
+	"ja":       {"{0}～{1}", "約 {0}"},
+	"ka":       {"{0}-{1}", "≈{0}"},
+	"ko":       {"{0}~{1}", "~{0}"},
+	"mk":       {"{0}\u2009–\u2009{1}", "~{0}"},
+	"my":       {"{0} - {1}", "~{0}"},
+	"nl":       {"{0}-{1}", "~{0}"},
+	"no":       {"{0}–{1}", "ca. {0}"},
+	"pt-PT":    {"{0} - {1}", "~{0}"},
+	"ro":       {"{0} - {1}", "~{0}"},
+	"ru":       {"{0}–{1}", "≈{0}"},
+	"sk":       {"{0} – {1}", "~{0}"},
+	"sl":       {"{0}–{1}", "~ {0}"},
+	"sq":       {"{0}-{1}", "≈{0}"},
+	"th":       {"{0}-{1}", "~{0}"},
+	"vi":       {"{0}-{1}", "~{0}"},
+	"yue":      {"{0}-{1}", "~{0}"},
+	"yue-Hans": {"{0}-{1}", "~{0}"},
+	"zh":       {"{0}-{1}", "~{0}"},
+	"zh-Hant":  {"{0}-{1}", "~{0}"},
 }
 
 var countryCurrencies = map[string]string{
@@ -919,7 +1171,7 @@ var countryCurrencies = map[string]string{
 	"AI": "XCD", "AL": "ALL", "AM": "AMD", "AO": "AOA", "AR": "ARS",
 	"AS": "USD", "AT": "EUR", "AU": "AUD", "AW": "AWG", "AX": "EUR",
 	"AZ": "AZN", "BA": "BAM", "BB": "BBD", "BD": "BDT", "BE": "EUR",
-	"BF": "XOF", "BG": "BGN", "BH": "BHD", "BI": "BIF", "BJ": "XOF",
+	"BF": "XOF", "BG": "EUR", "BH": "BHD", "BI": "BIF", "BJ": "XOF",
 	"BL": "EUR", "BM": "BMD", "BN": "BND", "BO": "BOB", "BQ": "USD",
 	"BR": "BRL", "BS": "BSD", "BT": "BTN", "BV": "NOK", "BW": "BWP",
 	"BY": "BYN", "BZ": "BZD", "CA": "CAD", "CC": "AUD", "CD": "CDF",
@@ -965,7 +1217,7 @@ var countryCurrencies = map[string]string{
 	"US": "USD", "UY": "UYU", "UZ": "UZS", "VA": "EUR", "VC": "XCD",
 	"VE": "VES", "VG": "USD", "VI": "USD", "VN": "VND", "VU": "VUV",
 	"WF": "XPF", "WS": "WST", "XK": "EUR", "YE": "YER", "YT": "EUR",
-	"ZA": "ZAR", "ZM": "ZMW", "ZW": "USD",
+	"ZA": "ZAR", "ZM": "ZMW", "ZW": "ZWG",
 }
 
 var parentLocales = map[string]string{
@@ -976,51 +1228,57 @@ var parentLocales = map[string]string{
 	"en-BM": "en-001", "en-BS": "en-001", "en-BW": "en-001",
 	"en-BZ": "en-001", "en-CC": "en-001", "en-CH": "en-150",
 	"en-CK": "en-001", "en-CM": "en-001", "en-CX": "en-001",
-	"en-CY": "en-001", "en-DE": "en-150", "en-DG": "en-001",
-	"en-DK": "en-150", "en-DM": "en-001", "en-ER": "en-001",
+	"en-CY": "en-001", "en-CZ": "en-150", "en-DE": "en-150",
+	"en-DG": "en-001", "en-DK": "en-150", "en-DM": "en-001",
+	"en-EE": "en-150", "en-ER": "en-001", "en-ES": "en-150",
 	"en-FI": "en-150", "en-FJ": "en-001", "en-FK": "en-001",
-	"en-FM": "en-001", "en-GB": "en-001", "en-GD": "en-001",
-	"en-GG": "en-001", "en-GH": "en-001", "en-GI": "en-001",
-	"en-GM": "en-001", "en-GY": "en-001", "en-HK": "en-001",
-	"en-ID": "en-001", "en-IE": "en-001", "en-IL": "en-001",
-	"en-IM": "en-001", "en-IN": "en-001", "en-IO": "en-001",
-	"en-JE": "en-001", "en-JM": "en-001", "en-KE": "en-001",
-	"en-KI": "en-001", "en-KN": "en-001", "en-KY": "en-001",
-	"en-LC": "en-001", "en-LR": "en-001", "en-LS": "en-001",
-	"en-MG": "en-001", "en-MO": "en-001", "en-MS": "en-001",
-	"en-MT": "en-001", "en-MU": "en-001", "en-MV": "en-001",
-	"en-MW": "en-001", "en-MY": "en-001", "en-NA": "en-001",
-	"en-NF": "en-001", "en-NG": "en-001", "en-NL": "en-150",
-	"en-NR": "en-001", "en-NU": "en-001", "en-NZ": "en-001",
-	"en-PG": "en-001", "en-PK": "en-001", "en-PN": "en-001",
-	"en-PW": "en-001", "en-RW": "en-001", "en-SB": "en-001",
-	"en-SC": "en-001", "en-SD": "en-001", "en-SE": "en-150",
-	"en-SG": "en-001", "en-SH": "en-001", "en-SI": "en-150",
+	"en-FM": "en-001", "en-FR": "en-150", "en-GB": "en-001",
+	"en-GD": "en-001", "en-GE": "en-150", "en-GG": "en-001",
+	"en-GH": "en-001", "en-GI": "en-001", "en-GM": "en-001",
+	"en-GS": "en-001", "en-GY": "en-001", "en-HK": "en-001",
+	"en-HU": "en-150", "en-ID": "en-001", "en-IE": "en-001",
+	"en-IL": "en-001", "en-IM": "en-001", "en-IN": "en-001",
+	"en-IO": "en-001", "en-IT": "en-150", "en-JE": "en-001",
+	"en-JM": "en-001", "en-KE": "en-001", "en-KI": "en-001",
+	"en-KN": "en-001", "en-KY": "en-001", "en-LC": "en-001",
+	"en-LR": "en-001", "en-LS": "en-001", "en-LT": "en-150",
+	"en-LV": "en-150", "en-MG": "en-001", "en-MO": "en-001",
+	"en-MS": "en-001", "en-MT": "en-001", "en-MU": "en-001",
+	"en-MV": "en-001", "en-MW": "en-001", "en-MY": "en-001",
+	"en-NA": "en-001", "en-NF": "en-001", "en-NG": "en-001",
+	"en-NL": "en-150", "en-NO": "en-150", "en-NR": "en-001",
+	"en-NU": "en-001", "en-NZ": "en-001", "en-PG": "en-001",
+	"en-PK": "en-001", "en-PL": "en-150", "en-PN": "en-001",
+	"en-PT": "en-150", "en-PW": "en-001", "en-RO": "en-150",
+	"en-RW": "en-001", "en-SB": "en-001", "en-SC": "en-001",
+	"en-SD": "en-001", "en-SE": "en-150", "en-SG": "en-001",
+	"en-SH": "en-001", "en-SI": "en-150", "en-SK": "en-150",
 	"en-SL": "en-001", "en-SS": "en-001", "en-SX": "en-001",
 	"en-SZ": "en-001", "en-TC": "en-001", "en-TK": "en-001",
 	"en-TO": "en-001", "en-TT": "en-001", "en-TV": "en-001",
-	"en-TZ": "en-001", "en-UG": "en-001", "en-VC": "en-001",
-	"en-VG": "en-001", "en-VU": "en-001", "en-WS": "en-001",
-	"en-ZA": "en-001", "en-ZM": "en-001", "en-ZW": "en-001",
-	"es-AR": "es-419", "es-BO": "es-419", "es-BR": "es-419",
-	"es-BZ": "es-419", "es-CL": "es-419", "es-CO": "es-419",
-	"es-CR": "es-419", "es-CU": "es-419", "es-DO": "es-419",
-	"es-EC": "es-419", "es-GT": "es-419", "es-HN": "es-419",
-	"es-JP": "es-419", "es-MX": "es-419", "es-NI": "es-419",
-	"es-PA": "es-419", "es-PE": "es-419", "es-PR": "es-419",
-	"es-PY": "es-419", "es-SV": "es-419", "es-US": "es-419",
-	"es-UY": "es-419", "es-VE": "es-419", "hi-Latn": "en-IN",
-	"ht": "fr-HT", "iu-Latn": "en", "kk-Arab": "en",
-	"ks-Deva": "en", "ku-Arab": "en", "kxv-Deva": "en",
-	"kxv-Orya": "en", "kxv-Telu": "en", "ky-Arab": "en",
-	"ky-Latn": "en", "mn-Mong": "en", "mni-Mtei": "en",
-	"ms-Arab": "en", "nb": "no", "nn": "no",
-	"no-NO": "no", "pa-Arab": "en", "pt-AO": "pt-PT",
-	"pt-CH": "pt-PT", "pt-CV": "pt-PT", "pt-FR": "pt-PT",
-	"pt-GQ": "pt-PT", "pt-GW": "pt-PT", "pt-LU": "pt-PT",
-	"pt-MO": "pt-PT", "pt-MZ": "pt-PT", "pt-ST": "pt-PT",
-	"pt-TL": "pt-PT", "so-Arab": "en", "sr-Latn": "en",
-	"sw-Arab": "en", "tg-Arab": "en", "ug-Cyrl": "en",
-	"uz-Arab": "en", "uz-Cyrl": "en", "yue-Hans": "en",
-	"zh-Hant": "en", "zh-Hant-MO": "zh-Hant-HK",
+	"en-TZ": "en-001", "en-UA": "en-150", "en-UG": "en-001",
+	"en-VC": "en-001", "en-VG": "en-001", "en-VU": "en-001",
+	"en-WS": "en-001", "en-ZA": "en-001", "en-ZM": "en-001",
+	"en-ZW": "en-001", "es-AR": "es-419", "es-BO": "es-419",
+	"es-BR": "es-419", "es-BZ": "es-419", "es-CL": "es-419",
+	"es-CO": "es-419", "es-CR": "es-419", "es-CU": "es-419",
+	"es-DO": "es-419", "es-EC": "es-419", "es-GT": "es-419",
+	"es-HN": "es-419", "es-JP": "es-419", "es-MX": "es-419",
+	"es-NI": "es-419", "es-PA": "es-419", "es-PE": "es-419",
+	"es-PR": "es-419", "es-PY": "es-419", "es-SV": "es-419",
+	"es-US": "es-419", "es-UY": "es-419", "es-VE": "es-419",
+	"hi-Latn": "en-IN", "ht": "fr-HT", "iu-Latn": "en",
+	"kk-Arab": "en", "kok-Latn": "en", "ks-Deva": "en",
+	"ku-Arab": "en", "kxv-Deva": "en", "kxv-Orya": "en",
+	"kxv-Telu": "en", "ky-Arab": "en", "ky-Latn": "en",
+	"mn-Mong": "en", "mni-Mtei": "en", "ms-Arab": "en",
+	"nb": "no", "nn": "no", "no-NO": "no",
+	"pa-Arab": "en", "pt-AO": "pt-PT", "pt-CH": "pt-PT",
+	"pt-CV": "pt-PT", "pt-FR": "pt-PT", "pt-GQ": "pt-PT",
+	"pt-GW": "pt-PT", "pt-LU": "pt-PT", "pt-MO": "pt-PT",
+	"pt-MZ": "pt-PT", "pt-ST": "pt-PT", "pt-TL": "pt-PT",
+	"so-Arab": "en", "sr-Latn": "en", "sw-Arab": "en",
+	"tg-Arab": "en", "ug-Cyrl": "en", "uz-Arab": "en",
+	"uz-Cyrl": "en", "yue-Hans": "en", "zh-Hant": "en",
+	"zh-Hant-MO": "zh-Hant-HK",
 }
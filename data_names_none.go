@@ -0,0 +1,14 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+//go:build !currency_names
+// +build !currency_names
+
+package currency
+
+// Currency names are left out unless building with the "currency_names" tag.
+var currencyNames map[string]map[string]nameInfo
+
+var currencyUnitPatterns map[string]string
+
+var pluralRules map[string][]pluralRule
@@ -4,8 +4,10 @@
 package currency_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/plenigo/currency"
 )
@@ -32,6 +34,18 @@ func ExampleNewAmountFromInt64() {
 	// 60 JPY
 }
 
+func ExampleAmount_Format() {
+	amount, _ := currency.NewAmount("1234.565", "USD")
+	fmt.Printf("%v\n", amount)
+	fmt.Printf("%.2f\n", amount)
+	fmt.Printf("%d\n", amount)
+	fmt.Printf("[%12.2v]\n", amount)
+	// Output: 1234.565 USD
+	// 1234.57
+	// 123457
+	// [ 1234.57 USD]
+}
+
 func ExampleAmount_Int64() {
 	firstAmount, _ := currency.NewAmount("24.49", "USD")
 	secondAmount, _ := currency.NewAmount("50", "USD")
@@ -43,6 +57,49 @@ func ExampleAmount_Int64() {
 	// Output: 2449 5000 60
 }
 
+func ExampleAmount_Units() {
+	amount, _ := currency.NewAmount("-12.75", "USD")
+	// Fill a google.type.Money message.
+	units, nanos, _ := amount.Units()
+	fmt.Println(amount.CurrencyCode(), units, nanos)
+
+	// Convert it back to an amount.
+	amount, _ = currency.NewAmountFromUnits(units, nanos, "USD")
+	fmt.Println(amount)
+	// Output: USD -12 -750000000
+	// -12.75 USD
+}
+
+// paymentAmount is an amount in the JSON format of a payment provider.
+type paymentAmount currency.Amount
+
+var paymentFormat = currency.JSONFormat{
+	NumberField:       "amount",
+	MinorUnits:        true,
+	Numeric:           true,
+	LowercaseCurrency: true,
+}
+
+func (a paymentAmount) MarshalJSON() ([]byte, error) {
+	return paymentFormat.Marshal(currency.Amount(a))
+}
+
+func (a *paymentAmount) UnmarshalJSON(data []byte) error {
+	return paymentFormat.Unmarshal(data, (*currency.Amount)(a))
+}
+
+func ExampleJSONFormat() {
+	amount, _ := currency.NewAmount("12.50", "EUR")
+	data, _ := json.Marshal(paymentAmount(amount))
+	fmt.Println(string(data))
+
+	var decoded paymentAmount
+	_ = json.Unmarshal([]byte(`{"amount":999,"currency":"usd"}`), &decoded)
+	fmt.Println(currency.Amount(decoded))
+	// Output: {"amount":1250,"currency":"eur"}
+	// 9.99 USD
+}
+
 func ExampleAmount_Convert() {
 	amount, _ := currency.NewAmount("20.99", "USD")
 	amount, _ = amount.Convert("EUR", "0.91")
@@ -52,6 +109,24 @@ func ExampleAmount_Convert() {
 	// 19.10 EUR
 }
 
+func ExampleConverter_Convert() {
+	provider := currency.NewStaticRateProvider()
+	provider.Set("EUR", "USD", "1.25")
+	provider.Set("EUR", "JPY", "160")
+	converter := currency.NewConverter(provider)
+	converter.BaseCurrency = "EUR"
+
+	amount, _ := currency.NewAmount("20.99", "USD")
+	// USD->EUR is calculated from the inverse EUR->USD rate.
+	eurAmount, _ := converter.Convert(amount, "EUR", time.Now())
+	// USD->JPY is calculated from the USD->EUR and EUR->JPY rates.
+	jpyAmount, _ := converter.Convert(amount, "JPY", time.Now())
+	fmt.Println(eurAmount)
+	fmt.Println(jpyAmount)
+	// Output: 16.79 EUR
+	// 2687 JPY
+}
+
 func ExampleAmount_Add() {
 	firstAmount, _ := currency.NewAmount("20.99", "USD")
 	secondAmount, _ := currency.NewAmount("3.50", "USD")
@@ -108,6 +183,20 @@ func ExampleAmount_Div() {
 	// Output: 33.33 USD
 }
 
+func ExampleAmount_Allocate() {
+	amount, _ := currency.NewAmount("100", "USD")
+	parts, _ := amount.Allocate("1", "1", "1")
+	fmt.Println(parts)
+	// Output: [33.34 USD 33.33 USD 33.33 USD]
+}
+
+func ExampleAmount_Split() {
+	amount, _ := currency.NewAmount("100", "JPY")
+	parts, _ := amount.Split(3)
+	fmt.Println(parts)
+	// Output: [34 JPY 33 JPY 33 JPY]
+}
+
 func ExampleAmount_Round() {
 	firstAmount, _ := currency.NewAmount("12.345", "USD")
 	secondAmount, _ := currency.NewAmount("12.345", "JPY")
@@ -129,6 +218,15 @@ func ExampleAmount_RoundTo() {
 	// 12 USD
 }
 
+func ExampleAmount_RoundCash() {
+	firstAmount, _ := currency.NewAmount("12.32", "CHF")
+	secondAmount, _ := currency.NewAmount("12.50", "SEK")
+	fmt.Println(firstAmount.RoundCash())
+	fmt.Println(secondAmount.RoundCash())
+	// Output: 12.30 CHF
+	// 13 SEK
+}
+
 func ExampleNewLocale() {
 	firstLocale := currency.NewLocale("en-US")
 	fmt.Println(firstLocale)
@@ -184,6 +282,19 @@ func ExampleFormatter_Format() {
 	// 1245
 }
 
+func ExampleFormatter_Format_compact() {
+	locale := currency.NewLocale("en")
+	formatter := currency.NewFormatter(locale)
+	formatter.CompactStyle = true
+	amount, _ := currency.NewAmount("1234567.89", "USD")
+	fmt.Println(formatter.Format(amount))
+
+	amount, _ = currency.NewAmount("45900", "USD")
+	fmt.Println(formatter.Format(amount))
+	// Output: $1.2M
+	// $46K
+}
+
 func ExampleFormatter_Parse() {
 	locale := currency.NewLocale("tr")
 	formatter := currency.NewFormatter(locale)
@@ -201,6 +312,19 @@ func ExampleFormatter_Parse() {
 	// 1234.59 EUR
 }
 
+func ExampleSpellOut() {
+	amount, _ := currency.NewAmount("123.45", "EUR")
+	for _, localeID := range []string{"en", "de", "fr", "es", "pl"} {
+		spelled, _ := currency.SpellOut(amount, currency.NewLocale(localeID))
+		fmt.Println(spelled)
+	}
+	// Output: one hundred twenty-three euros and forty-five cents
+	// einhundertdreiundzwanzig Euro und fünfundvierzig Cent
+	// cent vingt-trois euros et quarante-cinq centimes
+	// ciento veintitrés euros con cuarenta y cinco céntimos
+	// sto dwadzieścia trzy euro czterdzieści pięć centów
+}
+
 func ExampleForCountryCode() {
 	currencyCode, ok := currency.ForCountryCode("US")
 	fmt.Println(currencyCode, ok)
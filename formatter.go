@@ -4,12 +4,44 @@
 package currency
 
 import (
+	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"unicode"
 	"unicode/utf8"
+
+	"github.com/cockroachdb/apd/v3"
 )
 
+// ParseError is returned when a formatted amount can't be parsed
+// using strict parsing, or its currency can't be detected.
+type ParseError struct {
+	// Input is the string being parsed.
+	Input string
+	// Position is the byte offset at which the error was found.
+	Position int
+	// Reason describes the error.
+	Reason string
+}
+
+func (e ParseError) Error() string {
+	return fmt.Sprintf("parse %q: %v at position %d", e.Input, e.Reason, e.Position)
+}
+
+// AmbiguousCurrencyError is returned when a currency symbol matches multiple currencies.
+type AmbiguousCurrencyError struct {
+	Symbol string
+	// Candidates are the matching currency codes, sorted alphabetically.
+	Candidates []string
+}
+
+func (e AmbiguousCurrencyError) Error() string {
+	return fmt.Sprintf("ambiguous currency symbol %q: could be one of %v", e.Symbol, strings.Join(e.Candidates, ", "))
+}
+
 // Display represents the currency display type.
 type Display uint8
 
@@ -20,24 +52,33 @@ const (
 	DisplayCode
 	// DisplayNone shows nothing, hiding the currency.
 	DisplayNone
+	// DisplayName shows the currency name, matching the amount's plural form.
+	// For example, "3.00 USD" in the "en" locale is formatted as "3.00 US dollars".
+	DisplayName
 )
 
-var localDigits = map[numberingSystem]string{
-	numArab:    "٠١٢٣٤٥٦٧٨٩",
-	numArabExt: "۰۱۲۳۴۵۶۷۸۹",
-	numBeng:    "০১২৩৪৫৬৭৮৯",
-	numDeva:    "०१२३४५६७८९",
-	numMymr:    "၀၁၂၃၄၅၆၇၈၉",
-}
-
 // Formatter formats and parses currency amounts.
 type Formatter struct {
-	locale Locale
-	format currencyFormat
+	locale         Locale
+	resolvedLocale Locale
+	format         currencyFormat
+	compactFormat  []string
+	rangeFormat    rangeFormat
+	pattern        *customPattern
+	compiled       *compiledFormat
+	localeIDs      []string
 	// AccountingStyle formats the amount using the accounting style.
 	// For example, "-3.00 USD" in the "en" locale is formatted as "($3.00)" instead of "-$3.00".
 	// Defaults to false.
 	AccountingStyle bool
+	// CompactStyle formats the amount using the compact (short) style.
+	// For example, "1234567.89 USD" in the "en" locale is formatted as "$1.2M".
+	// Compacted numbers are rounded to two significant digits ("$1.2K", "$12K"),
+	// with MaxDigits further limiting the number of fraction digits.
+	// MaxSignificantDigits, if set, is used instead of both.
+	// Amounts too small to be compacted are formatted as usual.
+	// Defaults to false.
+	CompactStyle bool
 	// AddPlusSign inserts the plus sign in front of positive amounts.
 	// Defaults to false.
 	AddPlusSign bool
@@ -52,12 +93,47 @@ type Formatter struct {
 	// Formatted amounts will be rounded to this number of digits.
 	// Defaults to 6, so that most amounts are shown as-is (without rounding).
 	MaxDigits uint8
+	// MinSignificantDigits specifies the minimum number of significant digits.
+	// Trailing zeroes are added until it is reached ("0.5" => "0.500" with 3).
+	// Setting it or MaxSignificantDigits replaces MinDigits, MaxDigits and the
+	// currency's default digits with significant digits.
+	// Capped by MaxSignificantDigits, if set. Defaults to 0 (disabled).
+	MinSignificantDigits uint8
+	// MaxSignificantDigits specifies the maximum number of significant digits.
+	// Formatted amounts will be rounded to this number of significant digits,
+	// using RoundingMode ("1234.56" => "1,200" and "0.0012345" => "0.0012" with 2).
+	// With CompactStyle, replaces the default of two significant digits.
+	// Defaults to 0 (disabled).
+	MaxSignificantDigits uint8
+	// MinIntegerDigits specifies the minimum number of integer digits.
+	// Leading zeroes are added until it is reached ("5.00" => "005.00" with 3).
+	// Defaults to 0 (disabled, equivalent to 1).
+	MinIntegerDigits uint8
 	// RoundingMode specifies how the formatted amount will be rounded.
 	// Defaults to currency.RoundHalfUp.
 	RoundingMode RoundingMode
-	// CurrencyDisplay specifies how the currency will be displayed (symbol/code/none).
+	// CashRounding rounds the amount using the currency's cash rounding rules
+	// and RoundingMode before formatting (e.g. "12.34 CHF" is formatted as "CHF 12.35").
+	// DefaultDigits then refers to the currency's cash digits (e.g. 0 for SEK).
+	// Defaults to false.
+	CashRounding bool
+	// CurrencyDisplay specifies how the currency will be displayed (symbol/code/none/name).
 	// Defaults to currency.DisplaySymbol.
 	CurrencyDisplay Display
+	// NumberingSystem specifies the numbering system used for digits.
+	// The locale's separators and signs for the numbering system are used
+	// when available (e.g. "ar-EG" with NumLatn uses "." instead of "٫").
+	// Defaults to the locale's numbering system, or its "nu" override.
+	// It can be changed after NewFormatter: the format data for
+	// the new numbering system is then compiled once, on first use.
+	NumberingSystem NumberingSystem
+	// StrictParsing validates amounts against the locale's format when parsing.
+	// Grouping separators must be in the right positions, only the given
+	// currency's symbols and code are allowed, and parentheses are accepted
+	// only if AccountingStyle is enabled and the accounting pattern uses them.
+	// Errors are returned as a ParseError.
+	// Defaults to false.
+	StrictParsing bool
 	// SymbolMap specifies custom symbols for individual currency codes.
 	// For example, "USD": "$" means that the $ symbol will be used even if
 	// the current locale's symbol is different ("US$", "$US", etc).
@@ -65,68 +141,305 @@ type Formatter struct {
 }
 
 // NewFormatter creates a new formatter for the given locale.
+//
+// The locale's numbering system override (e.g. "ar-EG-u-nu-latn") is honored.
 func NewFormatter(locale Locale) *Formatter {
+	format, resolvedLocale := getLocaleFormat(locale)
 	f := &Formatter{
 		locale:          locale,
-		format:          getFormat(locale),
+		resolvedLocale:  resolvedLocale,
+		format:          format,
+		compactFormat:   getCompactFormat(locale),
+		rangeFormat:     getRangeFormat(locale),
+		compiled:        compileFormat(format),
+		localeIDs:       symbolLocaleIDs(locale),
 		MinDigits:       DefaultDigits,
 		MaxDigits:       6,
 		RoundingMode:    RoundHalfUp,
 		CurrencyDisplay: DisplaySymbol,
+		NumberingSystem: format.numberingSystem,
 		SymbolMap:       make(map[string]string),
 	}
 	return f
 }
 
+// getLocaleFormat returns the format for a locale, honoring its numbering system override.
+//
+// Also returns the locale the format was found for, after parent fallback.
+func getLocaleFormat(locale Locale) (currencyFormat, Locale) {
+	format, resolvedLocale := getFormat(locale)
+	if numSystem, ok := numberingSystems[locale.NumberingSystem]; ok && numSystem != format.numberingSystem {
+		format = getNumberingSystemFormat(locale, numSystem)
+	}
+
+	return format, resolvedLocale
+}
+
 // Locale returns the locale.
 func (f *Formatter) Locale() Locale {
 	return f.locale
 }
 
+// ResolvedLocale returns the locale whose format data is used.
+//
+// This is the formatter's locale or the closest parent with its own
+// format data (e.g. "de" for "de-DE", "en" for "xx"). Includes the
+// numbering system, if it differs from the locale's default.
+func (f *Formatter) ResolvedLocale() Locale {
+	locale := f.resolvedLocale
+	if format, _ := getFormat(locale); f.NumberingSystem != format.numberingSystem && f.NumberingSystem.isValid() {
+		locale.NumberingSystem = f.NumberingSystem.String()
+	}
+	return locale
+}
+
+// SetPattern sets a custom pattern, used instead of the locale's pattern.
+//
+// The pattern uses the CLDR syntax, with an optional negative subpattern
+// separated by ";" (e.g. "¤¤ #,##0.00;¤¤ #,##0.00-"). Supported symbols:
+//
+//	¤         the currency, as specified by CurrencyDisplay
+//	¤¤        the currency code
+//	#,##0.00  the number, with the grouping sizes ("#,##,##0.00" for lakhs)
+//	-, +      the locale's minus and plus signs
+//	'...'     quoted literal text ("''" for a single quote)
+//
+// Other characters are used as-is. The number of fraction digits in the
+// pattern is ignored, as with CLDR currency patterns, fraction digits are
+// determined by the currency, MinDigits and MaxDigits. The locale's separators
+// and signs are still used. If the negative subpattern is omitted, the
+// positive pattern is prefixed with the minus sign.
+//
+// The pattern is used for both formatting and parsing, and takes
+// precedence over AccountingStyle. An empty pattern restores the locale's pattern.
+// Returns an InvalidPatternError if the pattern is invalid or unsupported.
+func (f *Formatter) SetPattern(pattern string) error {
+	if pattern == "" {
+		f.pattern = nil
+		f.format, _ = getLocaleFormat(f.locale)
+		f.compiled = compileFormat(f.format)
+		return nil
+	}
+	p, err := parsePattern(pattern)
+	if err != nil {
+		return err
+	}
+	f.pattern = p
+	f.format = p.apply(f.format)
+	f.compiled = compileFormat(f.format)
+
+	return nil
+}
+
 // Format formats a currency amount.
 func (f *Formatter) Format(amount Amount) string {
+	var buf [64]byte
+
+	return string(f.AppendFormat(buf[:0], amount))
+}
+
+// AppendFormat appends the formatted currency amount to dst and returns the extended buffer.
+//
+// Produces the same output as Format, but doesn't allocate in the common case,
+// as the patterns are precomputed by NewFormatter. Intended for hot paths
+// such as formatting large price lists, with dst reused between calls.
+// CompactStyle, DisplayName and CashRounding are supported, but allocate.
+func (f *Formatter) AppendFormat(dst []byte, amount Amount) []byte {
+	if nf, ok := f.withNumberingSystem(); ok {
+		f = &nf
+	}
+	if f.compiled == nil || f.CompactStyle || f.CurrencyDisplay == DisplayName {
+		for _, part := range f.FormatToParts(amount) {
+			dst = append(dst, part.Value...)
+		}
+		return dst
+	}
+	patterns := &f.compiled.patterns
+	if f.usesAccountingPattern() {
+		patterns = &f.compiled.accountingPatterns
+	}
+	p := &patterns[patternPositive]
+	number := amount.number
+	if number.Sign() < 0 {
+		// The minus sign will be provided by the pattern.
+		p = &patterns[patternNegative]
+		number.Negative = false
+	} else if f.AddPlusSign {
+		p = &patterns[patternPlusSign]
+	}
+	currencyCode := amount.CurrencyCode()
+	formattedCurrency := f.formatCurrency(currencyCode)
+	segments := p.segments
+	if formattedCurrency == "" {
+		segments = p.segmentsNoCurrency
+	} else if p.currencyCode {
+		formattedCurrency = currencyCode
+	}
+	for _, s := range segments {
+		switch s.kind {
+		case segmentNumber:
+			amount, minDigits, maxDigits := f.numberDigits(Amount{number, currencyCode})
+			dst = f.appendDecimal(dst, nil, amount.number, minDigits, maxDigits)
+		case segmentCurrency:
+			// CLDR requires having a space between the letters
+			// in a currency symbol and adjacent numbers.
+			if p.spaceBefore {
+				if r, _ := utf8.DecodeRuneInString(formattedCurrency); unicode.IsLetter(r) {
+					dst = append(dst, "\u00a0"...)
+				}
+			}
+			dst = append(dst, formattedCurrency...)
+			if p.spaceAfter {
+				if r, _ := utf8.DecodeLastRuneInString(formattedCurrency); unicode.IsLetter(r) {
+					dst = append(dst, "\u00a0"...)
+				}
+			}
+		default:
+			dst = append(dst, s.value...)
+		}
+	}
+
+	return dst
+}
+
+// FormatToParts formats a currency amount, returning the individual parts.
+//
+// Allows styling each part differently (e.g. showing the fraction as superscript).
+// The values of the returned parts always concatenate to the output of Format().
+func (f *Formatter) FormatToParts(amount Amount) []Part {
+	if nf, ok := f.withNumberingSystem(); ok {
+		f = &nf
+	}
 	pattern := f.getPattern(amount)
-	if amount.IsNegative() {
+	negative := amount.IsNegative()
+	if negative {
 		// The minus sign will be provided by the pattern.
 		amount, _ = amount.Mul("-1")
 	}
-	formattedNumber := f.formatNumber(amount)
+	var numberParts []Part
+	if compactPattern, compactAmount, maxDigits, ok := f.compact(amount); ok {
+		pattern = f.getCompactPattern(compactPattern, negative)
+		numberParts = f.formatDecimal(compactAmount, 0, maxDigits)
+	} else {
+		numberParts = f.formatNumber(amount)
+	}
 	formattedCurrency := f.formatCurrency(amount.CurrencyCode())
+	if strings.Contains(pattern, "¤¤") {
+		// Custom patterns can require the currency code.
+		pattern = strings.Replace(pattern, "¤¤", "¤", 1)
+		if formattedCurrency != "" {
+			formattedCurrency = amount.CurrencyCode()
+		}
+	}
+	var currencySpacing string
 	if formattedCurrency != "" {
 		// CLDR requires having a space between the letters
 		// in a currency symbol and adjacent numbers.
 		if strings.Contains(pattern, "0¤") {
 			r, _ := utf8.DecodeRuneInString(formattedCurrency)
 			if unicode.IsLetter(r) {
-				formattedCurrency = "\u00a0" + formattedCurrency
+				currencySpacing = "\u00a0"
 			}
 		} else if strings.Contains(pattern, "¤0") {
 			r, _ := utf8.DecodeLastRuneInString(formattedCurrency)
 			if unicode.IsLetter(r) {
-				formattedCurrency = formattedCurrency + "\u00a0"
+				currencySpacing = "\u00a0"
 			}
 		}
 	}
 
-	replacements := []string{
-		"0.00", formattedNumber,
-		"+", f.format.plusSign,
-		"-", f.format.minusSign,
+	parts := make([]Part, 0, len(numberParts)+4)
+	for i := 0; i < len(pattern); {
+		switch {
+		case strings.HasPrefix(pattern[i:], "0.00"):
+			parts = append(parts, numberParts...)
+			i += len("0.00")
+		case pattern[i] == '+':
+			parts = appendPart(parts, PartPlusSign, f.format.plusSign)
+			i++
+		case pattern[i] == '-':
+			parts = appendPart(parts, PartMinusSign, f.format.minusSign)
+			i++
+		case formattedCurrency == "" && strings.HasPrefix(pattern[i:], "\u00a0¤"):
+			// Many patterns have a non-breaking space between
+			// the number and currency, not needed in this case.
+			i += len("\u00a0¤")
+		case formattedCurrency == "" && strings.HasPrefix(pattern[i:], "¤\u00a0"):
+			i += len("¤\u00a0")
+		case strings.HasPrefix(pattern[i:], "¤"):
+			if formattedCurrency != "" {
+				if strings.Contains(pattern, "0¤") {
+					parts = appendPart(parts, PartLiteral, currencySpacing)
+					parts = appendPart(parts, PartCurrency, formattedCurrency)
+				} else {
+					parts = appendPart(parts, PartCurrency, formattedCurrency)
+					parts = appendPart(parts, PartLiteral, currencySpacing)
+				}
+			}
+			i += len("¤")
+		default:
+			_, size := utf8.DecodeRuneInString(pattern[i:])
+			parts = appendPart(parts, PartLiteral, pattern[i:i+size])
+			i += size
+		}
 	}
-	if formattedCurrency == "" {
-		// Many patterns have a non-breaking space between
-		// the number and currency, not needed in this case.
-		replacements = append(replacements, "\u00a0¤", "", "¤\u00a0", "", "¤", "")
-	} else {
-		replacements = append(replacements, "¤", formattedCurrency)
+	if f.CurrencyDisplay == DisplayName {
+		parts = f.formatName(parts, joinParts(numberParts), amount.CurrencyCode())
 	}
-	r := strings.NewReplacer(replacements...)
 
-	return r.Replace(pattern)
+	return parts
+}
+
+// FormatRange formats a range of currency amounts.
+//
+// For example, "5.00 USD" to "10.00 USD" in the "en" locale is formatted as "$5.00–10.00".
+// The currency is shown only once if both amounts place it on the same side.
+// Amounts which are identical once formatted are shown as an approximate amount ("~$5.00").
+// Returns a MismatchError if the amounts have different currencies.
+func (f *Formatter) FormatRange(min, max Amount) (string, error) {
+	if nf, ok := f.withNumberingSystem(); ok {
+		f = &nf
+	}
+	if min.CurrencyCode() != max.CurrencyCode() {
+		return "", MismatchError{min, max}
+	}
+	formattedMin := f.Format(min)
+	formattedMax := f.Format(max)
+	if formattedMin == formattedMax {
+		return strings.Replace(f.rangeFormat.approximatelyPattern, "{0}", formattedMin, 1), nil
+	}
+	nf := *f
+	nf.CurrencyDisplay = DisplayNone
+	switch f.CurrencyDisplay {
+	case DisplaySymbol, DisplayCode:
+		minPattern := f.getPattern(min)
+		if minPattern == f.getPattern(max) {
+			if strings.HasPrefix(minPattern, "¤") {
+				formattedMax = nf.Format(max)
+			} else if strings.HasSuffix(minPattern, "¤") {
+				formattedMin = nf.Format(min)
+			}
+		}
+	case DisplayName:
+		// The name matches the plural form of the max amount ("1–2 US dollars").
+		formattedMax = nf.Format(max)
+		r := strings.NewReplacer("{0}", nf.Format(min), "{1}", formattedMax)
+		parts := []Part{{PartLiteral, r.Replace(f.rangeFormat.rangePattern)}}
+		return joinParts(f.formatName(parts, formattedMax, max.CurrencyCode())), nil
+	}
+	r := strings.NewReplacer("{0}", formattedMin, "{1}", formattedMax)
+
+	return r.Replace(f.rangeFormat.rangePattern), nil
 }
 
 // Parse parses a formatted amount.
 func (f *Formatter) Parse(s, currencyCode string) (Amount, error) {
+	if nf, ok := f.withNumberingSystem(); ok {
+		f = &nf
+	}
+	if f.StrictParsing {
+		return f.parseStrict(s, currencyCode)
+	}
 	symbol, _ := GetSymbol(currencyCode, f.locale)
 	replacements := []string{
 		f.format.decimalSeparator, ".",
@@ -140,38 +453,538 @@ func (f *Formatter) Parse(s, currencyCode string) (Amount, error) {
 		"\u00a0", "",
 		" ", "",
 	}
-	if f.format.numberingSystem != numLatn {
+	if f.format.numberingSystem != NumLatn {
 		digits := localDigits[f.format.numberingSystem]
 		for i, v := range strings.Split(digits, "") {
 			replacements = append(replacements, v, strconv.Itoa(i))
 		}
 	}
-	if f.AccountingStyle {
+	if f.pattern != nil {
+		for _, literal := range f.pattern.literals {
+			replacements = append(replacements, literal, "")
+		}
+	}
+	if f.AccountingStyle || (f.pattern != nil && f.pattern.parens) {
 		replacements = append(replacements, "(", "-", ")", "")
 	}
 	r := strings.NewReplacer(replacements...)
 	n := r.Replace(s)
+	if strings.HasSuffix(n, "-") && f.minusAfterNumber() {
+		n = "-" + strings.TrimSuffix(n, "-")
+	}
 
 	return NewAmount(n, currencyCode)
 }
 
+// ParseAny parses a formatted amount, detecting its currency.
+//
+// The currency is detected from an ISO currency code ("CHF 3.20"), or from
+// a currency symbol ("€12,50", "12.50 US$"). Symbols are first matched
+// against the formatter's locale (and SymbolMap), then against the symbols
+// used by all other locales. Returns an AmbiguousCurrencyError if the symbol
+// matches multiple currencies (e.g. "$" in the "fr" locale), and a ParseError
+// if no currency could be found.
+//
+// Digit grouping is validated even when StrictParsing is off, since the
+// separators are ambiguous when the amount comes from another locale
+// (e.g. "€12,50" in the "en" locale is rejected instead of parsed as 1250).
+func (f *Formatter) ParseAny(s string) (Amount, error) {
+	if nf, ok := f.withNumberingSystem(); ok {
+		f = &nf
+	}
+	currency, pos := f.findCurrency(s)
+	if currency == "" {
+		if pos := strings.IndexFunc(s, unicode.IsLetter); pos != -1 {
+			word := s[pos:]
+			if end := strings.IndexFunc(word, isNotLetter); end != -1 {
+				word = word[:end]
+			}
+			return Amount{}, ParseError{s, pos, fmt.Sprintf("unknown currency %q", word)}
+		}
+		return Amount{}, ParseError{s, 0, "missing currency"}
+	}
+	numberPos := strings.IndexFunc(s, func(r rune) bool {
+		_, ok := f.digitValue(r)
+		return ok
+	})
+	if numberPos == -1 {
+		return Amount{}, ParseError{s, len(s), "missing number"}
+	}
+	if !f.StrictParsing {
+		if _, _, err := f.parseStrictNumber(s, numberPos); err != nil {
+			return Amount{}, err
+		}
+	}
+	currencyCode := currency
+	if !IsValid(currency) {
+		candidates := f.symbolCurrencies(currency)
+		switch len(candidates) {
+		case 0:
+			return Amount{}, ParseError{s, pos, fmt.Sprintf("unknown currency symbol %q", currency)}
+		case 1:
+			currencyCode = candidates[0]
+		default:
+			return Amount{}, AmbiguousCurrencyError{currency, candidates}
+		}
+	}
+	if !f.StrictParsing {
+		// The symbol might not be the locale's own, replace it with
+		// the currency code so that Parse can recognize it.
+		s = s[:pos] + currencyCode + s[pos+len(currency):]
+	}
+
+	return f.Parse(s, currencyCode)
+}
+
+// findCurrency finds the first currency code or symbol in s.
+//
+// Returns the code or symbol, and its position.
+func (f *Formatter) findCurrency(s string) (string, int) {
+	symbols := make([]string, 0, len(f.SymbolMap))
+	for _, symbol := range f.SymbolMap {
+		symbols = append(symbols, symbol)
+	}
+	sort.Slice(symbols, func(i, j int) bool {
+		return len(symbols[i]) > len(symbols[j])
+	})
+	for pos, r := range s {
+		rest := s[pos:]
+		if _, ok := f.digitValue(r); ok {
+			continue
+		}
+		if len(rest) >= 3 && IsValid(rest[:3]) && isWord(s, pos, pos+3) {
+			return rest[:3], pos
+		}
+		if symbol := matchPrefix(rest, symbols); symbol != "" && isWord(s, pos, pos+len(symbol)) {
+			return symbol, pos
+		}
+		if symbol := matchPrefix(rest, getAllSymbols()); symbol != "" && isWord(s, pos, pos+len(symbol)) {
+			return symbol, pos
+		}
+	}
+
+	return "", 0
+}
+
+// isWord reports whether s[start:end] isn't part of a longer word,
+// e.g. "DT" (the TND symbol) in "USDT".
+func isWord(s string, start, end int) bool {
+	first, _ := utf8.DecodeRuneInString(s[start:end])
+	last, _ := utf8.DecodeLastRuneInString(s[start:end])
+	prev, _ := utf8.DecodeLastRuneInString(s[:start])
+	next, _ := utf8.DecodeRuneInString(s[end:])
+	if unicode.IsLetter(first) && unicode.IsLetter(prev) {
+		return false
+	}
+	return !unicode.IsLetter(last) || !unicode.IsLetter(next)
+}
+
+// isNotLetter reports whether r is not a letter.
+func isNotLetter(r rune) bool {
+	return !unicode.IsLetter(r)
+}
+
+// symbolCurrencies returns the currency codes which use the given symbol.
+//
+// Prefers currencies using the symbol in the formatter's locale.
+func (f *Formatter) symbolCurrencies(symbol string) []string {
+	var candidates []string
+	for currencyCode, customSymbol := range f.SymbolMap {
+		if customSymbol == symbol {
+			candidates = append(candidates, currencyCode)
+		}
+	}
+	for currencyCode := range currencySymbols {
+		if _, ok := f.SymbolMap[currencyCode]; ok {
+			continue
+		}
+		if localSymbol, _ := GetSymbol(currencyCode, f.locale); localSymbol == symbol {
+			candidates = append(candidates, currencyCode)
+		}
+	}
+	if len(candidates) == 0 {
+		for currencyCode, symbols := range currencySymbols {
+			for _, si := range symbols {
+				if si.symbol == symbol {
+					candidates = append(candidates, currencyCode)
+					break
+				}
+			}
+		}
+	}
+	sort.Strings(candidates)
+
+	return candidates
+}
+
+var allSymbols struct {
+	sync.Once
+	symbols []string
+}
+
+// getAllSymbols returns all known currency symbols, ordered from longest to shortest.
+func getAllSymbols() []string {
+	allSymbols.Do(func() {
+		seen := make(map[string]bool)
+		for _, symbols := range currencySymbols {
+			for _, si := range symbols {
+				if !seen[si.symbol] {
+					seen[si.symbol] = true
+					allSymbols.symbols = append(allSymbols.symbols, si.symbol)
+				}
+			}
+		}
+		sort.Slice(allSymbols.symbols, func(i, j int) bool {
+			a, b := allSymbols.symbols[i], allSymbols.symbols[j]
+			if len(a) != len(b) {
+				return len(a) > len(b)
+			}
+			return a < b
+		})
+	})
+
+	return allSymbols.symbols
+}
+
+// parseStrict parses a formatted amount, validating it against the locale's format.
+func (f *Formatter) parseStrict(s, currencyCode string) (Amount, error) {
+	if currencyCode == "" || !IsValid(currencyCode) {
+		return Amount{}, InvalidCurrencyCodeError{currencyCode}
+	}
+	currencies := f.currencyStrings(currencyCode)
+	minusSign := strings.Trim(f.format.minusSign, "\u200e\u200f\u061c")
+	plusSign := strings.Trim(f.format.plusSign, "\u200e\u200f\u061c")
+	allowParens := f.usesAccountingPattern() && strings.Contains(f.format.accountingPattern, "(")
+	var literals []string
+	if f.pattern != nil {
+		allowParens = f.pattern.parens
+		literals = f.pattern.literals
+	}
+	minusAfterNumber := f.minusAfterNumber()
+
+	var number string
+	var negative, hasSign, hasCurrency, openParen, closeParen bool
+	var signPos, currencyPos, numberPos, openParenPos, closeParenPos int
+	for pos := 0; pos < len(s); {
+		rest := s[pos:]
+		r, size := utf8.DecodeRuneInString(rest)
+		if r == '\u200e' || r == '\u200f' || r == '\u061c' || unicode.IsSpace(r) || r == '\u202f' {
+			pos += size
+			continue
+		}
+		if _, ok := f.digitValue(r); ok {
+			if number != "" || closeParen {
+				return Amount{}, ParseError{s, pos, "unexpected digit"}
+			}
+			var err error
+			numberPos = pos
+			number, pos, err = f.parseStrictNumber(s, pos)
+			if err != nil {
+				return Amount{}, err
+			}
+			continue
+		}
+		if literal := matchPrefix(rest, literals); literal != "" {
+			pos += len(literal)
+			continue
+		}
+		if currency := matchPrefix(rest, currencies); currency != "" {
+			if hasCurrency {
+				return Amount{}, ParseError{s, pos, "duplicate currency"}
+			}
+			hasCurrency, currencyPos = true, pos
+			pos += len(currency)
+			continue
+		}
+		switch {
+		case (number != "") == minusAfterNumber && !hasSign && (strings.HasPrefix(rest, minusSign) || r == '-'):
+			negative, hasSign, signPos = true, true, pos
+			if r == '-' {
+				pos += size
+			} else {
+				pos += len(minusSign)
+			}
+		case number == "" && !hasSign && (strings.HasPrefix(rest, plusSign) || r == '+'):
+			hasSign, signPos = true, pos
+			if r == '+' {
+				pos += size
+			} else {
+				pos += len(plusSign)
+			}
+		case allowParens && r == '(' && number == "" && !hasSign && !openParen:
+			openParen, openParenPos = true, pos
+			pos += size
+		case allowParens && r == ')' && number != "" && openParen && !closeParen:
+			closeParen, closeParenPos = true, pos
+			pos += size
+		default:
+			return Amount{}, ParseError{s, pos, f.unexpectedReason(rest)}
+		}
+	}
+	if number == "" {
+		return Amount{}, ParseError{s, len(s), "missing number"}
+	}
+	if openParen && !closeParen {
+		return Amount{}, ParseError{s, len(s), "missing closing parenthesis"}
+	}
+	if hasCurrency {
+		// The sign and parentheses must be on the same side of the
+		// currency as in the pattern, e.g. "-$5" but not "$-5" in "en".
+		signPattern, sign := selectPattern(f.format.standardPattern, false, true, false), "-"
+		if !negative {
+			signPattern, sign = selectPattern(f.format.standardPattern, false, false, true), "+"
+		}
+		if hasSign && !matchesPatternOrder(signPattern, sign, signPos, currencyPos, numberPos) {
+			return Amount{}, ParseError{s, signPos, "misplaced sign"}
+		}
+		if openParen {
+			parenPattern := selectPattern(f.format.standardPattern, false, true, false)
+			if f.usesAccountingPattern() {
+				parenPattern = selectPattern(f.format.accountingPattern, true, true, false)
+			}
+			if !matchesPatternOrder(parenPattern, "(", openParenPos, currencyPos, numberPos) {
+				return Amount{}, ParseError{s, openParenPos, "misplaced parenthesis"}
+			}
+			if !matchesPatternOrder(parenPattern, ")", closeParenPos, currencyPos, numberPos) {
+				return Amount{}, ParseError{s, closeParenPos, "misplaced parenthesis"}
+			}
+		}
+	}
+	if negative || openParen {
+		number = "-" + number
+	}
+
+	return NewAmount(number, currencyCode)
+}
+
+// matchesPatternOrder reports whether the mark (sign or parenthesis) and
+// the currency found in the input are in the same order as in the pattern.
+//
+// Only checked when both are on the same side of the number,
+// in the pattern and in the input.
+func matchesPatternOrder(pattern, mark string, markPos, currencyPos, numberPos int) bool {
+	patternMark := strings.Index(pattern, mark)
+	patternCurrency := strings.Index(pattern, "¤")
+	patternNumber := strings.IndexAny(pattern, "#0")
+	if patternMark == -1 || patternCurrency == -1 || patternNumber == -1 {
+		return true
+	}
+	if (patternMark < patternNumber) != (patternCurrency < patternNumber) {
+		return true
+	}
+	if (markPos < numberPos) != (currencyPos < numberPos) {
+		return true
+	}
+
+	return (patternMark < patternCurrency) == (markPos < currencyPos)
+}
+
+// parseStrictNumber parses the number starting at the given position.
+//
+// Returns the number with latin digits and the position after it.
+func (f *Formatter) parseStrictNumber(s string, pos int) (string, int, error) {
+	groupingSeparator := f.format.groupingSeparator
+	decimalSeparator := f.format.decimalSeparator
+	var groups []string
+	var groupPositions []int
+	var fraction []byte
+	hasDecimal := false
+	digits := make([]byte, 0, 16)
+	groupStart := pos
+	for pos < len(s) {
+		rest := s[pos:]
+		r, size := utf8.DecodeRuneInString(rest)
+		if v, ok := f.digitValue(r); ok {
+			if hasDecimal {
+				fraction = append(fraction, '0'+v)
+			} else {
+				digits = append(digits, '0'+v)
+			}
+			pos += size
+			continue
+		}
+		if groupingSeparator != "" && strings.HasPrefix(rest, groupingSeparator) && f.startsWithDigit(rest[len(groupingSeparator):]) {
+			if hasDecimal {
+				return "", 0, ParseError{s, pos, "grouping separator in fraction"}
+			}
+			if f.NoGrouping || f.format.primaryGroupingSize == 0 {
+				return "", 0, ParseError{s, pos, "unexpected grouping separator"}
+			}
+			groups = append(groups, string(digits))
+			groupPositions = append(groupPositions, groupStart)
+			digits = digits[:0]
+			pos += len(groupingSeparator)
+			groupStart = pos
+			continue
+		}
+		if strings.HasPrefix(rest, decimalSeparator) {
+			if hasDecimal {
+				return "", 0, ParseError{s, pos, "multiple decimal separators"}
+			}
+			if !f.startsWithDigit(rest[len(decimalSeparator):]) {
+				return "", 0, ParseError{s, pos + len(decimalSeparator), "missing fraction digits"}
+			}
+			hasDecimal = true
+			pos += len(decimalSeparator)
+			continue
+		}
+		break
+	}
+	groups = append(groups, string(digits))
+	groupPositions = append(groupPositions, groupStart)
+
+	if len(groups) > 1 {
+		// The last group uses the primary size, the others use the secondary size.
+		// The first group can be shorter.
+		primarySize := int(f.format.primaryGroupingSize)
+		secondarySize := int(f.format.secondaryGroupingSize)
+		for i, group := range groups {
+			var valid bool
+			switch i {
+			case len(groups) - 1:
+				valid = len(group) == primarySize
+			case 0:
+				valid = len(group) >= 1 && len(group) <= secondarySize
+			default:
+				valid = len(group) == secondarySize
+			}
+			if !valid {
+				return "", 0, ParseError{s, groupPositions[i], "invalid digit grouping"}
+			}
+		}
+	}
+	number := strings.Join(groups, "")
+	if hasDecimal {
+		number += "." + string(fraction)
+	}
+
+	return number, pos, nil
+}
+
+// currencyStrings returns the strings which can represent the given currency,
+// ordered from longest to shortest.
+func (f *Formatter) currencyStrings(currencyCode string) []string {
+	currencies := []string{currencyCode}
+	if symbol, ok := f.SymbolMap[currencyCode]; ok {
+		currencies = append(currencies, symbol)
+	}
+	for _, s := range currencySymbols[currencyCode] {
+		currencies = append(currencies, s.symbol)
+	}
+	sort.SliceStable(currencies, func(i, j int) bool {
+		return len(currencies[i]) > len(currencies[j])
+	})
+
+	return currencies
+}
+
+// unexpectedReason returns the reason for rejecting the start of s.
+func (f *Formatter) unexpectedReason(s string) string {
+	if len(s) >= 3 && IsValid(s[:3]) {
+		return fmt.Sprintf("mismatched currency code %q", s[:3])
+	}
+	symbol := ""
+	for _, symbols := range currencySymbols {
+		for _, si := range symbols {
+			if len(si.symbol) > len(symbol) && strings.HasPrefix(s, si.symbol) {
+				symbol = si.symbol
+			}
+		}
+	}
+	if symbol != "" {
+		return fmt.Sprintf("mismatched currency symbol %q", symbol)
+	}
+	r, _ := utf8.DecodeRuneInString(s)
+
+	return fmt.Sprintf("unexpected character %q", r)
+}
+
+// digitValue returns the value of a latin or localized digit.
+func (f *Formatter) digitValue(r rune) (byte, bool) {
+	if r >= '0' && r <= '9' {
+		return byte(r - '0'), true
+	}
+	if f.format.numberingSystem != NumLatn {
+		i := 0
+		for _, d := range localDigits[f.format.numberingSystem] {
+			if d == r {
+				return byte(i), true
+			}
+			i++
+		}
+	}
+
+	return 0, false
+}
+
+// startsWithDigit returns whether s starts with a latin or localized digit.
+func (f *Formatter) startsWithDigit(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	_, ok := f.digitValue(r)
+	return ok
+}
+
+// matchPrefix returns the first of the given prefixes that s starts with.
+func matchPrefix(s string, prefixes []string) string {
+	for _, prefix := range prefixes {
+		if prefix != "" && strings.HasPrefix(s, prefix) {
+			return prefix
+		}
+	}
+	return ""
+}
+
+// withNumberingSystem returns a copy of f using the format data of f.NumberingSystem.
+//
+// Returns false unless the numbering system was changed after creation.
+// The format data is compiled on first use and cached on f.compiled.
+func (f *Formatter) withNumberingSystem() (Formatter, bool) {
+	if f.NumberingSystem == f.format.numberingSystem || !f.NumberingSystem.isValid() {
+		return Formatter{}, false
+	}
+	var nsFormat *numberingSystemFormat
+	if f.compiled != nil {
+		nsFormat, _ = f.compiled.numbering.Load().(*numberingSystemFormat)
+	}
+	if nsFormat == nil || nsFormat.numberingSystem != f.NumberingSystem {
+		nsFormat = &numberingSystemFormat{numberingSystem: f.NumberingSystem}
+		nsFormat.format = getNumberingSystemFormat(f.locale, f.NumberingSystem)
+		if f.pattern != nil {
+			nsFormat.format = f.pattern.apply(nsFormat.format)
+		}
+		nsFormat.compiled = compileFormat(nsFormat.format)
+		if f.compiled != nil {
+			f.compiled.numbering.Store(nsFormat)
+		}
+	}
+	nf := *f
+	nf.format = nsFormat.format
+	nf.compiled = nsFormat.compiled
+
+	return nf, true
+}
+
 // getPattern returns a positive or negative pattern for a currency amount.
 func (f *Formatter) getPattern(amount Amount) string {
-	var patterns []string
 	if f.usesAccountingPattern() {
-		patterns = strings.Split(f.format.accountingPattern, ";")
-	} else {
-		patterns = strings.Split(f.format.standardPattern, ";")
+		return selectPattern(f.format.accountingPattern, true, amount.IsNegative(), f.AddPlusSign)
 	}
 
+	return selectPattern(f.format.standardPattern, false, amount.IsNegative(), f.AddPlusSign)
+}
+
+// selectPattern returns a positive or negative pattern from the given
+// standard or accounting pattern.
+func selectPattern(pattern string, accounting, negative, addPlusSign bool) string {
+	patterns := strings.Split(pattern, ";")
 	switch {
-	case amount.IsNegative():
+	case negative:
 		if len(patterns) == 1 {
 			return "-" + patterns[0]
 		}
 		return patterns[1]
-	case f.AddPlusSign:
-		if len(patterns) == 1 || f.usesAccountingPattern() {
+	case addPlusSign:
+		if len(patterns) == 1 || accounting {
 			return "+" + patterns[0]
 		}
 		return strings.Replace(patterns[1], "-", "+", 1)
@@ -180,46 +993,445 @@ func (f *Formatter) getPattern(amount Amount) string {
 	}
 }
 
+// Pattern variants, used to index compiled patterns.
+const (
+	patternPositive = iota
+	patternNegative
+	patternPlusSign
+)
+
+// compiledFormat holds the state precomputed from a currency format,
+// used by AppendFormat to avoid processing patterns on each call.
+type compiledFormat struct {
+	// patterns are indexed by pattern variant.
+	patterns [3]compiledPattern
+	// accountingPatterns are indexed by pattern variant.
+	accountingPatterns [3]compiledPattern
+	// digits are the numbering system's digits, empty for latin digits.
+	digits [10]string
+	// numbering caches the *numberingSystemFormat of the last
+	// NumberingSystem set after NewFormatter.
+	numbering atomic.Value
+}
+
+// numberingSystemFormat is the format data for a numbering system
+// other than the one the formatter was created with.
+type numberingSystemFormat struct {
+	numberingSystem NumberingSystem
+	format          currencyFormat
+	compiled        *compiledFormat
+}
+
+// compiledPattern is a pattern split into segments.
+type compiledPattern struct {
+	segments []patternSegment
+	// segmentsNoCurrency are used when there is no currency to display,
+	// without the non-breaking space between the number and the currency.
+	segmentsNoCurrency []patternSegment
+	// currencyCode indicates that the pattern requires the currency code ("¤¤").
+	currencyCode bool
+	// spaceBefore and spaceAfter indicate which side of the currency
+	// is adjacent to the number, needing a space if the currency ends in a letter.
+	spaceBefore bool
+	spaceAfter  bool
+}
+
+// Pattern segment kinds.
+const (
+	segmentLiteral uint8 = iota
+	segmentNumber
+	segmentCurrency
+)
+
+// patternSegment is a single part of a compiled pattern.
+type patternSegment struct {
+	kind uint8
+	// value is the text of a literal segment, with signs localized.
+	value string
+}
+
+// compileFormat precomputes the patterns and digits of a currency format.
+func compileFormat(format currencyFormat) *compiledFormat {
+	c := &compiledFormat{}
+	for variant := range c.patterns {
+		negative, addPlusSign := variant == patternNegative, variant == patternPlusSign
+		c.patterns[variant] = compilePattern(selectPattern(format.standardPattern, false, negative, addPlusSign), format)
+		if format.accountingPattern != "" {
+			c.accountingPatterns[variant] = compilePattern(selectPattern(format.accountingPattern, true, negative, addPlusSign), format)
+		}
+	}
+	if format.numberingSystem != NumLatn {
+		for i, digit := range strings.Split(localDigits[format.numberingSystem], "") {
+			c.digits[i] = digit
+		}
+	}
+
+	return c
+}
+
+// compilePattern splits a positive or negative pattern into segments.
+func compilePattern(pattern string, format currencyFormat) compiledPattern {
+	p := compiledPattern{}
+	if strings.Contains(pattern, "¤¤") {
+		// Custom patterns can require the currency code.
+		pattern = strings.Replace(pattern, "¤¤", "¤", 1)
+		p.currencyCode = true
+	}
+	p.spaceBefore = strings.Contains(pattern, "0¤")
+	p.spaceAfter = !p.spaceBefore && strings.Contains(pattern, "¤0")
+	p.segments = compileSegments(pattern, format, false)
+	p.segmentsNoCurrency = compileSegments(pattern, format, true)
+
+	return p
+}
+
+// compileSegments splits a pattern into segments, merging adjacent literals.
+func compileSegments(pattern string, format currencyFormat, noCurrency bool) []patternSegment {
+	var segments []patternSegment
+	literal := strings.Builder{}
+	addSegment := func(kind uint8) {
+		if literal.Len() > 0 {
+			segments = append(segments, patternSegment{segmentLiteral, literal.String()})
+			literal.Reset()
+		}
+		segments = append(segments, patternSegment{kind: kind})
+	}
+	for i := 0; i < len(pattern); {
+		switch {
+		case strings.HasPrefix(pattern[i:], "0.00"):
+			addSegment(segmentNumber)
+			i += len("0.00")
+		case pattern[i] == '+':
+			literal.WriteString(format.plusSign)
+			i++
+		case pattern[i] == '-':
+			literal.WriteString(format.minusSign)
+			i++
+		case noCurrency && strings.HasPrefix(pattern[i:], "\u00a0¤"):
+			i += len("\u00a0¤")
+		case noCurrency && strings.HasPrefix(pattern[i:], "¤\u00a0"):
+			i += len("¤\u00a0")
+		case strings.HasPrefix(pattern[i:], "¤"):
+			if !noCurrency {
+				addSegment(segmentCurrency)
+			}
+			i += len("¤")
+		default:
+			_, size := utf8.DecodeRuneInString(pattern[i:])
+			literal.WriteString(pattern[i : i+size])
+			i += size
+		}
+	}
+	if literal.Len() > 0 {
+		segments = append(segments, patternSegment{segmentLiteral, literal.String()})
+	}
+
+	return segments
+}
+
+// getCompactPattern returns a positive or negative pattern for a compact pattern.
+func (f *Formatter) getCompactPattern(compactPattern string, negative bool) string {
+	// Replace the digits placeholder ("¤00K") with the one used by other patterns.
+	start := strings.Index(compactPattern, "0")
+	end := start + strings.Count(compactPattern, "0")
+	pattern := compactPattern[:start] + "0.00" + compactPattern[end:]
+	if negative {
+		return "-" + pattern
+	} else if f.AddPlusSign {
+		return "+" + pattern
+	}
+
+	return pattern
+}
+
+// compact compacts a positive amount using the locale's compact format.
+//
+// Returns the matching compact pattern, the compacted amount and its
+// maximum number of fraction digits, or false if the amount can't be compacted.
+func (f *Formatter) compact(amount Amount) (string, Amount, uint8, bool) {
+	if !f.CompactStyle || len(f.compactFormat) == 0 || amount.IsZero() {
+		return "", Amount{}, 0, false
+	}
+	if f.CashRounding {
+		// Cash rounding can change the magnitude ("999.60 SEK" => "1000 SEK").
+		amount = f.roundCash(amount)
+	}
+	intDigits := int(amount.number.NumDigits()) + int(amount.number.Exponent)
+	for {
+		magnitude := intDigits - 1
+		if magnitude < 3 {
+			return "", Amount{}, 0, false
+		}
+		if magnitude > 14 {
+			magnitude = 14
+		}
+		pattern := f.compactFormat[magnitude-3]
+		zeroes := strings.Count(pattern, "0")
+		if strings.Trim(pattern, "0") == "" {
+			// The locale doesn't compact numbers of this magnitude.
+			return "", Amount{}, 0, false
+		}
+		shift := magnitude - zeroes + 1
+		compacted := Amount{amount.number, amount.currencyCode}
+		compacted.number.Exponent -= int32(shift)
+		compactedDigits := intDigits - shift
+		// Round to two significant digits, unless specified otherwise.
+		significantDigits := 2
+		if f.MaxSignificantDigits > 0 {
+			significantDigits = int(f.MaxSignificantDigits)
+		}
+		maxDigits := uint8(0)
+		if compactedDigits < significantDigits {
+			maxDigits = uint8(significantDigits - compactedDigits)
+		}
+		if f.MaxDigits < maxDigits && f.MaxSignificantDigits == 0 {
+			maxDigits = f.MaxDigits
+		}
+		compacted = compacted.RoundTo(maxDigits, f.RoundingMode)
+		roundedDigits := int(compacted.number.NumDigits()) + int(compacted.number.Exponent)
+		if roundedDigits > compactedDigits && magnitude < 14 {
+			// Rounding produced an additional digit ("999.95K" => "1000K"),
+			// try again with the next magnitude ("1M").
+			intDigits++
+			continue
+		}
+
+		return pattern, compacted, maxDigits, true
+	}
+}
+
 // usesAccountingPattern returns whether the formatter needs to use the accounting pattern.
 func (f *Formatter) usesAccountingPattern() bool {
 	return f.AccountingStyle && f.format.accountingPattern != ""
 }
 
+// minusAfterNumber returns whether the custom pattern places the minus sign after the number.
+func (f *Formatter) minusAfterNumber() bool {
+	if f.pattern == nil {
+		return false
+	}
+	patterns := strings.Split(f.pattern.pattern, ";")
+	if len(patterns) != 2 {
+		return false
+	}
+
+	return strings.Index(patterns[1], "-") > strings.Index(patterns[1], "0.00")
+}
+
 // formatNumber formats the number for display.
-func (f *Formatter) formatNumber(amount Amount) string {
+func (f *Formatter) formatNumber(amount Amount) []Part {
+	amount, minDigits, maxDigits := f.numberDigits(amount)
+
+	return f.formatDecimal(amount, minDigits, maxDigits)
+}
+
+// numberDigits prepares a positive amount for display.
+//
+// Returns the amount, cash rounded if needed, and the minimum and
+// maximum number of fraction digits to display it with.
+func (f *Formatter) numberDigits(amount Amount) (Amount, uint8, uint8) {
+	defaultDigits, _ := GetDigits(amount.CurrencyCode())
+	if f.CashRounding {
+		amount = f.roundCash(amount)
+		defaultDigits, _ = GetCashDigits(amount.CurrencyCode())
+	}
+	if f.MinSignificantDigits > 0 || f.MaxSignificantDigits > 0 {
+		return f.roundSignificant(amount)
+	}
 	minDigits := f.MinDigits
 	if minDigits == DefaultDigits {
-		minDigits, _ = GetDigits(amount.CurrencyCode())
+		minDigits = defaultDigits
 	}
 	maxDigits := f.MaxDigits
 	if maxDigits == DefaultDigits {
-		maxDigits, _ = GetDigits(amount.CurrencyCode())
+		maxDigits = defaultDigits
+	}
+
+	return amount, minDigits, maxDigits
+}
+
+// roundCash rounds the amount using the cash rounding rules of its currency,
+// and the formatter's rounding mode.
+func (f *Formatter) roundCash(amount Amount) Amount {
+	if amount.currencyCode == "" {
+		return amount.RoundTo(DefaultDigits, f.RoundingMode)
+	}
+
+	return amount.roundToIncrement(cashIncrement(amount.currencyCode), f.RoundingMode)
+}
+
+// roundSignificant rounds a positive amount to the formatter's significant digits.
+//
+// Returns the rounded amount, and the minimum and maximum number
+// of fraction digits needed to display it.
+func (f *Formatter) roundSignificant(amount Amount) (Amount, uint8, uint8) {
+	minSignificant := int(f.MinSignificantDigits)
+	maxSignificant := int(f.MaxSignificantDigits)
+	if minSignificant == 0 {
+		minSignificant = 1
+	}
+	if maxSignificant > 0 && minSignificant > maxSignificant {
+		minSignificant = maxSignificant
+	}
+	number := amount.number
+	if maxSignificant > 0 && !number.IsZero() {
+		ctx := *roundingContext(&number, f.RoundingMode)
+		ctx.Precision = uint32(maxSignificant)
+		ctx.Round(&number, &number)
+	}
+	if number.Exponent > 0 {
+		// Rounding to fewer digits than the integer part has ("1.2E+5").
+		number.Coeff.SetMathBigInt(scaleCoefficient(&number, 0))
+		number.Exponent = 0
+	}
+	// The position of the most significant digit (0 for ones, -1 for tenths, etc).
+	magnitude := 0
+	if !number.IsZero() {
+		magnitude = int(number.NumDigits()) + int(number.Exponent) - 1
+	}
+	minDigits := minSignificant - magnitude - 1
+	if minDigits < 0 {
+		minDigits = 0
+	}
+	maxDigits := -int(number.Exponent)
+	if maxDigits < minDigits {
+		maxDigits = minDigits
+	}
+	if maxDigits > 255 {
+		maxDigits = 255
+	}
+	if minDigits > maxDigits {
+		minDigits = maxDigits
+	}
+
+	return Amount{number, amount.currencyCode}, uint8(minDigits), uint8(maxDigits)
+}
+
+// formatDecimal formats the number for display, using the given fraction digits.
+func (f *Formatter) formatDecimal(amount Amount, minDigits, maxDigits uint8) []Part {
+	var buf [64]byte
+	parts := make([]Part, 0, 4)
+	f.appendDecimal(buf[:0], &parts, amount.number, minDigits, maxDigits)
+
+	return parts
+}
+
+// appendDecimal appends the formatted positive number, using the given fraction digits.
+//
+// The digits are taken from the coefficient and exponent, never from the
+// scientific notation used by apd ("1E-7"). If parts is not nil, the appended
+// integer groups, separators and fraction are also recorded as parts.
+func (f *Formatter) appendDecimal(dst []byte, parts *[]Part, number apd.Decimal, minDigits, maxDigits uint8) []byte {
+	if number.Exponent < -int32(maxDigits) {
+		rounded := apd.Decimal{}
+		ctx := roundingContext(&number, f.RoundingMode)
+		if _, err := ctx.Quantize(&rounded, &number, -int32(maxDigits)); err == nil {
+			number = rounded
+		}
+	}
+	var buf [40]byte
+	digits := number.Coeff.Append(buf[:0], 10)
+	exponent := int(number.Exponent)
+	if number.IsZero() && exponent > 0 {
+		exponent = 0
+	}
+	for ; exponent > 0; exponent-- {
+		digits = append(digits, '0')
 	}
-	amount = amount.RoundTo(maxDigits, f.RoundingMode)
-	numberParts := strings.Split(amount.Number(), ".")
-	majorDigits := f.groupMajorDigits(numberParts[0])
-	minorDigits := ""
-	if len(numberParts) == 2 {
-		minorDigits = numberParts[1]
+	// Split the digits into the major and minor digits. The minor digits
+	// are preceded by leadingZeroes zeroes ("0.005" => "", 2, "5").
+	majorDigits, minorDigits, leadingZeroes := digits, digits[:0], 0
+	if n := len(digits) + exponent; n <= 0 {
+		majorDigits, minorDigits, leadingZeroes = nil, digits, -n
+	} else if exponent < 0 {
+		majorDigits, minorDigits = digits[:n], digits[n:]
 	}
+	numMinorDigits := int(maxDigits)
 	if minDigits < maxDigits {
-		// Strip any trailing zeroes.
-		minorDigits = strings.TrimRight(minorDigits, "0")
-		if len(minorDigits) < int(minDigits) {
-			// Now there are too few digits, re-add trailing zeroes
-			// until minDigits is reached.
-			minorDigits += strings.Repeat("0", int(minDigits)-len(minorDigits))
+		// Strip any trailing zeroes, then re-add them until minDigits is reached.
+		for len(minorDigits) > 0 && minorDigits[len(minorDigits)-1] == '0' {
+			minorDigits = minorDigits[:len(minorDigits)-1]
+		}
+		if len(minorDigits) == 0 {
+			leadingZeroes = 0
+		}
+		numMinorDigits = leadingZeroes + len(minorDigits)
+		if numMinorDigits < int(minDigits) {
+			numMinorDigits = int(minDigits)
 		}
 	}
-	b := strings.Builder{}
-	b.WriteString(majorDigits)
-	if minorDigits != "" {
-		b.WriteString(f.format.decimalSeparator)
-		b.WriteString(minorDigits)
+
+	// Major digits are padded to MinIntegerDigits (and at least one digit),
+	// then grouped from right to left: first the primary group, then the secondary groups.
+	numMajorDigits := len(majorDigits)
+	padding := int(f.MinIntegerDigits) - numMajorDigits
+	if padding < 1 && numMajorDigits == 0 {
+		padding = 1
+	} else if padding < 0 {
+		padding = 0
+	}
+	numMajorDigits += padding
+	primarySize := int(f.format.primaryGroupingSize)
+	secondarySize := int(f.format.secondaryGroupingSize)
+	grouping := !f.NoGrouping && primarySize > 0 && numMajorDigits >= int(f.format.minGroupingDigits)+primarySize
+	if secondarySize == 0 {
+		secondarySize = primarySize
+	}
+	partStart := len(dst)
+	for i := 0; i < numMajorDigits; i++ {
+		if remaining := numMajorDigits - i; grouping && i > 0 && remaining >= primarySize && (remaining-primarySize)%secondarySize == 0 {
+			partStart = recordPart(parts, PartInteger, dst, partStart)
+			dst = append(dst, f.format.groupingSeparator...)
+			partStart = recordPart(parts, PartGroup, dst, partStart)
+		}
+		digit := byte('0')
+		if i >= padding {
+			digit = majorDigits[i-padding]
+		}
+		dst = f.appendDigit(dst, digit)
+	}
+	partStart = recordPart(parts, PartInteger, dst, partStart)
+	if numMinorDigits > 0 {
+		dst = append(dst, f.format.decimalSeparator...)
+		partStart = recordPart(parts, PartDecimal, dst, partStart)
+		for i := 0; i < numMinorDigits; i++ {
+			digit := byte('0')
+			if j := i - leadingZeroes; j >= 0 && j < len(minorDigits) {
+				digit = minorDigits[j]
+			}
+			dst = f.appendDigit(dst, digit)
+		}
+		recordPart(parts, PartFraction, dst, partStart)
 	}
-	formatted := f.localizeDigits(b.String())
 
-	return formatted
+	return dst
+}
+
+// recordPart records dst[start:] as a part, if parts is not nil.
+//
+// Returns the start of the next part.
+func recordPart(parts *[]Part, partType PartType, dst []byte, start int) int {
+	if parts != nil {
+		*parts = appendPart(*parts, partType, string(dst[start:]))
+	}
+
+	return len(dst)
+}
+
+// appendDigit appends a latin digit, localized to the numbering system.
+func (f *Formatter) appendDigit(dst []byte, digit byte) []byte {
+	if f.compiled == nil {
+		// Formatters not created by NewFormatter have no precomputed digits.
+		if digits, ok := localDigits[f.format.numberingSystem]; ok {
+			return append(dst, string([]rune(digits)[digit-'0'])...)
+		}
+		return append(dst, digit)
+	}
+	if f.compiled.digits[0] == "" {
+		return append(dst, digit)
+	}
+
+	return append(dst, f.compiled.digits[digit-'0']...)
 }
 
 // formatCurrency formats the currency for display.
@@ -229,8 +1441,10 @@ func (f *Formatter) formatCurrency(currencyCode string) string {
 	case DisplaySymbol:
 		if symbol, ok := f.SymbolMap[currencyCode]; ok {
 			formatted = symbol
+		} else if currencyCode != "" && IsValid(currencyCode) {
+			formatted, _ = getSymbol(currencyCode, f.localeIDs)
 		} else {
-			formatted, _ = GetSymbol(currencyCode, f.locale)
+			formatted = currencyCode
 		}
 	case DisplayCode:
 		formatted = currencyCode
@@ -241,51 +1455,42 @@ func (f *Formatter) formatCurrency(currencyCode string) string {
 	return formatted
 }
 
-// groupMajorDigits groups major digits according to the currency format.
-func (f *Formatter) groupMajorDigits(majorDigits string) string {
-	if f.NoGrouping || f.format.primaryGroupingSize == 0 {
-		return majorDigits
+// formatName combines the formatted amount parts with the currency name.
+//
+// The formatted number is used to select the matching plural form.
+func (f *Formatter) formatName(parts []Part, formattedNumber, currencyCode string) []Part {
+	// Plural rules operate on latin digits, without grouping.
+	replacements := []string{f.format.decimalSeparator, "."}
+	if f.format.groupingSeparator != "" {
+		replacements = append(replacements, f.format.groupingSeparator, "")
 	}
-	numDigits := len(majorDigits)
-	minDigits := int(f.format.minGroupingDigits)
-	primarySize := int(f.format.primaryGroupingSize)
-	secondarySize := int(f.format.secondaryGroupingSize)
-	if numDigits < (minDigits + primarySize) {
-		return majorDigits
-	}
-
-	// Digits are grouped from right to left.
-	// First the primary group, then the secondary groups.
-	var groups []string
-	groups = append(groups, majorDigits[numDigits-primarySize:numDigits])
-	for i := numDigits - primarySize; i > 0; i = i - secondarySize {
-		low := i - secondarySize
-		if low < 0 {
-			low = 0
+	if f.format.numberingSystem != NumLatn {
+		digits := localDigits[f.format.numberingSystem]
+		for i, v := range strings.Split(digits, "") {
+			replacements = append(replacements, v, strconv.Itoa(i))
 		}
-		groups = append(groups, majorDigits[low:i])
 	}
-	// Reverse the groups and reconstruct the digits.
-	for i, j := 0, len(groups)-1; i < j; i, j = i+1, j-1 {
-		groups[i], groups[j] = groups[j], groups[i]
-	}
-	majorDigits = strings.Join(groups, f.format.groupingSeparator)
-
-	return majorDigits
-}
+	number := strings.NewReplacer(replacements...).Replace(formattedNumber)
+	name := getPluralName(currencyCode, f.locale, number)
 
-// localizeDigits replaces digits with their localized equivalents.
-func (f *Formatter) localizeDigits(number string) string {
-	if f.format.numberingSystem == numLatn {
-		return number
-	}
-	digits := localDigits[f.format.numberingSystem]
-	replacements := make([]string, 0, 20)
-	for i, v := range strings.Split(digits, "") {
-		replacements = append(replacements, strconv.Itoa(i), v)
+	unitPattern := getUnitPattern(f.locale)
+	nameParts := make([]Part, 0, len(parts)+2)
+	for i := 0; i < len(unitPattern); {
+		switch {
+		case strings.HasPrefix(unitPattern[i:], "{0}"):
+			for _, part := range parts {
+				nameParts = appendPart(nameParts, part.Type, part.Value)
+			}
+			i += len("{0}")
+		case strings.HasPrefix(unitPattern[i:], "{1}"):
+			nameParts = appendPart(nameParts, PartCurrency, name)
+			i += len("{1}")
+		default:
+			_, size := utf8.DecodeRuneInString(unitPattern[i:])
+			nameParts = appendPart(nameParts, PartLiteral, unitPattern[i:i+size])
+			i += size
+		}
 	}
-	r := strings.NewReplacer(replacements...)
-	number = r.Replace(number)
 
-	return number
+	return nameParts
 }
@@ -4,6 +4,10 @@
 package currency_test
 
 import (
+	"encoding/json"
+	"math/rand"
+	"reflect"
+	"strconv"
 	"testing"
 
 	"github.com/plenigo/currency"
@@ -27,20 +31,20 @@ func TestFormatter_Format(t *testing.T) {
 	}{
 		{"1234.59", "USD", "en-US", "$1,234.59"},
 		{"1234.59", "USD", "en-CA", "US$1,234.59"},
-		{"1234.59", "USD", "de-CH", "$\u00a01’234.59"},
+		{"1234.59", "USD", "de-CH", "$\u00a01'234.59"},
 		{"1234.59", "USD", "sr", "1.234,59\u00a0US$"},
 
 		{"-1234.59", "USD", "en-US", "-$1,234.59"},
 		{"-1234.59", "USD", "en-CA", "-US$1,234.59"},
-		{"-1234.59", "USD", "de-CH", "$-1’234.59"},
+		{"-1234.59", "USD", "de-CH", "$-1'234.59"},
 		{"-1234.59", "USD", "sr", "-1.234,59\u00a0US$"},
 
 		{"1234.00", "EUR", "en", "€1,234.00"},
-		{"1234.00", "EUR", "de-CH", "€\u00a01’234.00"},
+		{"1234.00", "EUR", "de-CH", "€\u00a01'234.00"},
 		{"1234.00", "EUR", "sr", "1.234,00\u00a0€"},
 
 		{"1234.00", "CHF", "en", "CHF\u00a01,234.00"},
-		{"1234.00", "CHF", "de-CH", "CHF\u00a01’234.00"},
+		{"1234.00", "CHF", "de-CH", "CHF\u00a01'234.00"},
 		{"1234.00", "CHF", "sr", "1.234,00\u00a0CHF"},
 
 		// An empty locale should be equivalent to "en".
@@ -48,7 +52,7 @@ func TestFormatter_Format(t *testing.T) {
 		{"-1234.59", "USD", "", "-$1,234.59"},
 
 		// Arabic digits.
-		{"12345678.90", "USD", "ar", "\u200f١٢٬٣٤٥٬٦٧٨٫٩٠\u00a0US$"},
+		{"12345678.90", "USD", "ar-EG", "\u200f١٢٬٣٤٥٬٦٧٨٫٩٠\u00a0US$"},
 		// Arabic extended (Persian) digits.
 		{"12345678.90", "USD", "fa", "\u200e$۱۲٬۳۴۵٬۶۷۸٫۹۰"},
 		// Bengali digits.
@@ -57,6 +61,16 @@ func TestFormatter_Format(t *testing.T) {
 		{"12345678.90", "USD", "ne", "US$\u00a0१,२३,४५,६७८.९०"},
 		// Myanmar (Burmese) digits.
 		{"12345678.90", "USD", "my", "၁၂,၃၄၅,၆၇၈.၉၀\u00a0US$"},
+
+		// Numbering system overrides.
+		{"12345678.90", "USD", "ar-EG-u-nu-latn", "\u200f12,345,678.90\u00a0US$"},
+		{"12345678.90", "USD", "bn-u-nu-latn", "1,23,45,678.90\u00a0US$"},
+		{"1234.59", "USD", "en-u-nu-arab", "$١٬٢٣٤٫٥٩"},
+		{"1234.59", "USD", "en-u-nu-deva", "$१,२३४.५९"},
+		// Unknown numbering systems are ignored.
+		{"1234.59", "USD", "en-u-nu-xxxx", "$1,234.59"},
+		// Other extensions don't affect formatting.
+		{"1234.59", "USD", "en-US-u-cu-eur-rg-gbzzzz", "$1,234.59"},
 	}
 
 	for _, tt := range tests {
@@ -228,6 +242,130 @@ func TestFormatter_Digits(t *testing.T) {
 	}
 }
 
+func TestFormatter_CashRounding(t *testing.T) {
+	tests := []struct {
+		number       string
+		currencyCode string
+		localeID     string
+		cashRounding bool
+		want         string
+	}{
+		{"12.34", "CHF", "de-CH", false, "CHF\u00a012.34"},
+		{"12.34", "CHF", "de-CH", true, "CHF\u00a012.35"},
+		{"-12.32", "CHF", "de-CH", true, "CHF-12.30"},
+		{"12.26", "DKK", "da", false, "12,26\u00a0kr."},
+		{"12.26", "DKK", "da", true, "12,50\u00a0kr."},
+		{"12.50", "SEK", "sv", false, "12,50\u00a0kr"},
+		{"12.50", "SEK", "sv", true, "13\u00a0kr"},
+		{"12.34", "EUR", "de", true, "12,34\u00a0€"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			amount, _ := currency.NewAmount(tt.number, tt.currencyCode)
+			locale := currency.NewLocale(tt.localeID)
+			formatter := currency.NewFormatter(locale)
+			formatter.CashRounding = tt.cashRounding
+			got := formatter.Format(amount)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatter_CashRoundingMode(t *testing.T) {
+	tests := []struct {
+		number       string
+		currencyCode string
+		localeID     string
+		roundingMode currency.RoundingMode
+		compact      bool
+		want         string
+	}{
+		{"12.325", "CHF", "de-CH", currency.RoundHalfUp, false, "CHF\u00a012.35"},
+		{"12.325", "CHF", "de-CH", currency.RoundHalfEven, false, "CHF\u00a012.30"},
+		{"-12.325", "CHF", "de-CH", currency.RoundHalfEven, false, "CHF-12.30"},
+		{"12.34", "CHF", "de-CH", currency.RoundDown, false, "CHF\u00a012.30"},
+		{"12.31", "CHF", "de-CH", currency.RoundUp, false, "CHF\u00a012.35"},
+		{"12.50", "SEK", "sv", currency.RoundHalfUp, false, "13\u00a0kr"},
+		{"12.50", "SEK", "sv", currency.RoundHalfEven, false, "12\u00a0kr"},
+		{"12.75", "DKK", "da", currency.RoundHalfEven, false, "13,00\u00a0kr."},
+		{"12.25", "DKK", "da", currency.RoundHalfDown, false, "12,00\u00a0kr."},
+		// Cash rounding is applied before compacting.
+		{"999.60", "SEK", "sv", currency.RoundHalfUp, true, "1\u00a0tn\u00a0kr"},
+		{"999.60", "SEK", "sv", currency.RoundDown, true, "999\u00a0kr"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			amount, _ := currency.NewAmount(tt.number, tt.currencyCode)
+			locale := currency.NewLocale(tt.localeID)
+			formatter := currency.NewFormatter(locale)
+			formatter.CashRounding = true
+			formatter.RoundingMode = tt.roundingMode
+			formatter.CompactStyle = tt.compact
+			got := formatter.Format(amount)
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+			got = string(formatter.AppendFormat(nil, amount))
+			if got != tt.want {
+				t.Errorf("AppendFormat: got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatter_CompactStyle(t *testing.T) {
+	tests := []struct {
+		number       string
+		currencyCode string
+		localeID     string
+		AddPlusSign  bool
+		want         string
+	}{
+		{"999.99", "USD", "en", false, "$999.99"},
+		{"1234.56", "USD", "en", false, "$1.2K"},
+		{"1000", "USD", "en", false, "$1K"},
+		{"12345.67", "USD", "en", false, "$12K"},
+		{"123456.78", "USD", "en", false, "$123K"},
+		{"999950", "USD", "en", false, "$1M"},
+		{"1234567.89", "USD", "en", false, "$1.2M"},
+		{"-1234567.89", "USD", "en", false, "-$1.2M"},
+		{"1234567.89", "USD", "en", true, "+$1.2M"},
+		{"1234567890123456", "USD", "en", false, "$1,235T"},
+		{"1234.56", "CHF", "en", false, "CHF\u00a01.2K"},
+		{"1234.56", "JPY", "en", false, "¥1.2K"},
+
+		// German doesn't compact thousands.
+		{"1234.56", "EUR", "de", false, "1.234,56\u00a0€"},
+		{"1234567.89", "EUR", "de", false, "1,2\u00a0Mio.\u00a0€"},
+		{"1234567.89", "EUR", "de-AT", false, "1,2\u00a0Mio.\u00a0€"},
+		{"1234567.89", "EUR", "fr", false, "1,2\u00a0M\u00a0€"},
+		{"1234.56", "PLN", "pl", false, "1,2\u00a0tys.\u00a0zł"},
+		{"1234567.89", "EUR", "pl", false, "1,2\u00a0mln\u00a0€"},
+		{"1234567.89", "EUR", "nl", false, "€\u00a01,2\u00a0mln."},
+		{"1234567.89", "EUR", "pt-BR", false, "€\u00a01,2\u00a0mi"},
+		{"1234567.89", "EUR", "sv", false, "1,2\u00a0mn\u00a0€"},
+		{"1234567.89", "EUR", "ru", false, "1,2\u00a0млн\u00a0€"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			amount, _ := currency.NewAmount(tt.number, tt.currencyCode)
+			locale := currency.NewLocale(tt.localeID)
+			formatter := currency.NewFormatter(locale)
+			formatter.CompactStyle = true
+			formatter.AddPlusSign = tt.AddPlusSign
+			got := formatter.Format(amount)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestFormatter_RoundingMode(t *testing.T) {
 	tests := []struct {
 		number       string
@@ -329,6 +467,288 @@ func TestFormatter_SymbolMap(t *testing.T) {
 	}
 }
 
+func TestFormatter_FormatRange(t *testing.T) {
+	tests := []struct {
+		min             string
+		max             string
+		currencyCode    string
+		localeID        string
+		minDigits       uint8
+		currencyDisplay currency.Display
+		want            string
+	}{
+		{"5", "10", "USD", "en", currency.DefaultDigits, currency.DisplaySymbol, "$5.00–10.00"},
+		{"5", "10", "USD", "en", 0, currency.DisplaySymbol, "$5–10"},
+		{"5", "10", "USD", "en", 0, currency.DisplayCode, "USD\u00a05–10"},
+		{"5", "10", "USD", "en", 0, currency.DisplayNone, "5–10"},
+		{"-10", "-5", "USD", "en", 0, currency.DisplaySymbol, "-$10–-$5"},
+		{"-5", "10", "USD", "en", 0, currency.DisplaySymbol, "-$5–$10"},
+		{"5", "10", "EUR", "de", 0, currency.DisplaySymbol, "5–10\u00a0€"},
+		{"5", "10", "EUR", "es", 0, currency.DisplaySymbol, "5-10\u00a0€"},
+		{"5", "10", "CHF", "de-CH", 0, currency.DisplaySymbol, "CHF\u00a05–10"},
+		{"500", "1000", "JPY", "ja", 0, currency.DisplaySymbol, "￥500～1,000"},
+		{"5", "10", "EUR", "bg", 0, currency.DisplaySymbol, "5 – 10\u00a0€"},
+		{"5", "10", "EUR", "ko", 0, currency.DisplaySymbol, "€5~10"},
+
+		// Identical amounts are shown as approximate.
+		{"5", "5", "EUR", "de", 0, currency.DisplaySymbol, "≈5\u00a0€"},
+		{"5", "5", "EUR", "fr", 0, currency.DisplaySymbol, "≈5\u00a0€"},
+		{"5", "5", "EUR", "no", 0, currency.DisplaySymbol, "ca. 5\u00a0€"},
+		{"5", "5", "EUR", "sl", 0, currency.DisplaySymbol, "~ 5\u00a0€"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			min, _ := currency.NewAmount(tt.min, tt.currencyCode)
+			max, _ := currency.NewAmount(tt.max, tt.currencyCode)
+			locale := currency.NewLocale(tt.localeID)
+			formatter := currency.NewFormatter(locale)
+			formatter.MinDigits = tt.minDigits
+			formatter.CurrencyDisplay = tt.currencyDisplay
+			got, err := formatter.FormatRange(min, max)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	min, _ := currency.NewAmount("1234", "USD")
+	max, _ := currency.NewAmount("1249", "USD")
+	formatter := currency.NewFormatter(currency.NewLocale("en"))
+	formatter.CompactStyle = true
+	got, _ := formatter.FormatRange(min, max)
+	if got != "~$1.2K" {
+		t.Errorf("got %v, want ~$1.2K", got)
+	}
+
+	min, _ = currency.NewAmount("5", "USD")
+	max, _ = currency.NewAmount("10", "EUR")
+	_, err := formatter.FormatRange(min, max)
+	if e, ok := err.(currency.MismatchError); ok {
+		if !e.A.Equal(min) || !e.B.Equal(max) {
+			t.Errorf("got %v, %v, want %v, %v", e.A, e.B, min, max)
+		}
+	} else {
+		t.Errorf("got %T, want currency.MismatchError", err)
+	}
+}
+
+func TestFormatter_FormatToParts(t *testing.T) {
+	amount, _ := currency.NewAmount("-1234.59", "CHF")
+	formatter := currency.NewFormatter(currency.NewLocale("en"))
+	got := formatter.FormatToParts(amount)
+	want := []currency.Part{
+		{Type: currency.PartMinusSign, Value: "-"},
+		{Type: currency.PartCurrency, Value: "CHF"},
+		{Type: currency.PartLiteral, Value: "\u00a0"},
+		{Type: currency.PartInteger, Value: "1"},
+		{Type: currency.PartGroup, Value: ","},
+		{Type: currency.PartInteger, Value: "234"},
+		{Type: currency.PartDecimal, Value: "."},
+		{Type: currency.PartFraction, Value: "59"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	formatter.AccountingStyle = true
+	got = formatter.FormatToParts(amount)
+	if got[0] != (currency.Part{Type: currency.PartLiteral, Value: "("}) {
+		t.Errorf("got %v, want {literal (}", got[0])
+	}
+
+	d, _ := json.Marshal(got[1])
+	if string(d) != `{"type":"currency","value":"CHF"}` {
+		t.Errorf("got %v, want {\"type\":\"currency\",\"value\":\"CHF\"}", string(d))
+	}
+}
+
+func TestFormatter_FormatToPartsConcatenation(t *testing.T) {
+	localeIDs := []string{
+		"en", "en-IN", "de", "de-AT", "de-CH", "fr", "fr-CH", "es", "sr", "sr-Latn", "ja",
+		"ar", "ar-DZ", "fa", "bn", "ne", "my", "he", "sv", "nl", "pt", "tr",
+	}
+	numbers := []string{"0", "1234.59", "-1234.59", "1234567.891", "-0.5", "0.0051", "100", "0.0000001", "-0.000000123456", "1E+3"}
+	currencyCodes := []string{"USD", "EUR", "CHF", "JPY"}
+	options := []string{"", "accounting", "compact", "plus", "nogrouping", "integer", "significant", "digits", "cash"}
+
+	for _, localeID := range localeIDs {
+		formatter := currency.NewFormatter(currency.NewLocale(localeID))
+		for _, number := range numbers {
+			for _, currencyCode := range currencyCodes {
+				amount, _ := currency.NewAmount(number, currencyCode)
+				for _, display := range []currency.Display{currency.DisplaySymbol, currency.DisplayCode, currency.DisplayNone} {
+					for _, option := range options {
+						formatter.CurrencyDisplay = display
+						formatter.AccountingStyle = option == "accounting"
+						formatter.CompactStyle = option == "compact"
+						formatter.AddPlusSign = option == "plus"
+						formatter.NoGrouping = option == "nogrouping"
+						formatter.CashRounding = option == "cash"
+						formatter.MinIntegerDigits = 0
+						formatter.MaxSignificantDigits = 0
+						formatter.MinDigits, formatter.MaxDigits = currency.DefaultDigits, 6
+						switch option {
+						case "integer":
+							formatter.MinIntegerDigits = 3
+						case "significant":
+							formatter.MaxSignificantDigits = 2
+						case "digits":
+							formatter.MinDigits, formatter.MaxDigits = 0, 1
+						}
+						parts := formatter.FormatToParts(amount)
+						got := ""
+						for _, part := range parts {
+							got += part.Value
+						}
+						want := formatter.Format(amount)
+						if got != want {
+							t.Errorf("%v %v %v: got %q, want %q", localeID, amount, option, got, want)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestFormatter_FormatToPartsRandom(t *testing.T) {
+	localeIDs := []string{"en", "de-CH", "fr", "ar", "fa", "bn", "hi", "ja", "my", "es"}
+	currencyCodes := []string{"USD", "EUR", "JPY", "KWD"}
+	numberingSystems := []currency.NumberingSystem{currency.NumLatn, currency.NumArab, currency.NumDeva}
+	patterns := []string{
+		"¤¤ #,##0.00;¤¤ #,##0.00-",
+		"#,##0.00 ¤¤",
+		"¤ #,##,##0.00",
+		"#0.00 ¤",
+		"¤#,##0.00;(¤#,##0.00)",
+		"'Total:' ¤#,##0.00",
+		"¤¤#,##0.00",
+		"#,##0.00",
+	}
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 5000; i++ {
+		// Random coefficients and exponents cover both tiny and large amounts.
+		number := strconv.FormatInt(rnd.Int63n(1000000000000)-500000000000, 10)
+		number += "E" + strconv.Itoa(rnd.Intn(25)-18)
+		amount, err := currency.NewAmount(number, currencyCodes[rnd.Intn(len(currencyCodes))])
+		if err != nil {
+			t.Fatalf("NewAmount(%q): %v", number, err)
+		}
+		formatter := currency.NewFormatter(currency.NewLocale(localeIDs[rnd.Intn(len(localeIDs))]))
+		if rnd.Intn(3) == 0 {
+			pattern := patterns[rnd.Intn(len(patterns))]
+			if err := formatter.SetPattern(pattern); err != nil {
+				t.Fatalf("SetPattern(%q): %v", pattern, err)
+			}
+		}
+		// CompactStyle and DisplayName aren't covered, since AppendFormat
+		// (and therefore Format) delegates to FormatToParts for them.
+		formatter.AccountingStyle = rnd.Intn(2) == 0
+		formatter.AddPlusSign = rnd.Intn(2) == 0
+		formatter.NoGrouping = rnd.Intn(4) == 0
+		formatter.CashRounding = rnd.Intn(4) == 0
+		formatter.MinDigits = uint8(rnd.Intn(4))
+		formatter.MaxDigits = formatter.MinDigits + uint8(rnd.Intn(10))
+		if rnd.Intn(4) == 0 {
+			formatter.MinSignificantDigits = uint8(rnd.Intn(4))
+			formatter.MaxSignificantDigits = uint8(1 + rnd.Intn(8))
+		}
+		formatter.MinIntegerDigits = uint8(rnd.Intn(4))
+		formatter.RoundingMode = currency.RoundingMode(rnd.Intn(5))
+		formatter.CurrencyDisplay = currency.Display(rnd.Intn(3))
+		if rnd.Intn(2) == 0 {
+			formatter.NumberingSystem = numberingSystems[rnd.Intn(len(numberingSystems))]
+		}
+
+		got := ""
+		for _, part := range formatter.FormatToParts(amount) {
+			got += part.Value
+		}
+		want := formatter.Format(amount)
+		if got != want {
+			t.Errorf("#%v %v %v: got %q, want %q", i, formatter.Locale(), amount, got, want)
+		}
+	}
+
+	// Tiny amounts keep all of their fraction digits.
+	formatter := currency.NewFormatter(currency.NewLocale("en"))
+	formatter.MaxDigits = 8
+	amount, _ := currency.NewAmount("0.0000001", "USD")
+	parts := formatter.FormatToParts(amount)
+	if got := parts[len(parts)-1].Value; got != "0000001" {
+		t.Errorf("got fraction %q, want \"0000001\"", got)
+	}
+	if got, want := formatter.Format(amount), "$0.0000001"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestFormatter_AppendFormat(t *testing.T) {
+	tests := []struct {
+		number       string
+		currencyCode string
+		localeID     string
+		want         string
+	}{
+		{"1234.59", "USD", "en", "Prices: $1,234.59"},
+		{"-1234.59", "EUR", "de-CH", "Prices: €-1'234.59"},
+		{"1234.59", "CHF", "fr", "Prices: 1\u202f234,59\u00a0CHF"},
+		{"-0.5", "USD", "ar-EG", "Prices: \u061c-\u200f٠٫٥٠\u00a0US$"},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			amount, _ := currency.NewAmount(tt.number, tt.currencyCode)
+			formatter := currency.NewFormatter(currency.NewLocale(tt.localeID))
+			got := string(formatter.AppendFormat([]byte("Prices: "), amount))
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatter_AppendFormatAllocs(t *testing.T) {
+	amount, _ := currency.NewAmount("-1234.59", "EUR")
+	for _, localeID := range []string{"en", "de-CH", "fr", "ar", "hi", "ja"} {
+		formatter := currency.NewFormatter(currency.NewLocale(localeID))
+		buf := make([]byte, 0, 64)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = formatter.AppendFormat(buf[:0], amount)
+		})
+		if allocs != 0 {
+			t.Errorf("%v: got %v allocs, want 0", localeID, allocs)
+		}
+	}
+}
+
+func TestFormatter_AppendFormatAllocs_NumberingSystem(t *testing.T) {
+	amount, _ := currency.NewAmount("-1234.59", "EUR")
+	for _, localeID := range []string{"en", "ar-EG", "fa"} {
+		formatter := currency.NewFormatter(currency.NewLocale(localeID))
+		formatter.NumberingSystem = currency.NumArab
+		if localeID != "en" {
+			formatter.NumberingSystem = currency.NumLatn
+		}
+		buf := make([]byte, 0, 64)
+		// The first call compiles the format for the numbering system.
+		buf = formatter.AppendFormat(buf[:0], amount)
+		want := formatter.Format(amount)
+		if string(buf) != want {
+			t.Errorf("%v: got %q, want %q", localeID, buf, want)
+		}
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = formatter.AppendFormat(buf[:0], amount)
+		})
+		if allocs != 0 {
+			t.Errorf("%v: got %v allocs, want 0", localeID, allocs)
+		}
+	}
+}
+
 func TestFormatter_Parse(t *testing.T) {
 	tests := []struct {
 		s            string
@@ -355,7 +775,7 @@ func TestFormatter_Parse(t *testing.T) {
 		{"1234,00", "EUR", "de-AT", "1234.00"},
 
 		// Arabic digits.
-		{"١٢٬٣٤٥٬٦٧٨٫٩٠\u00a0US$", "USD", "ar", "12345678.90"},
+		{"١٢٬٣٤٥٬٦٧٨٫٩٠\u00a0US$", "USD", "ar-EG", "12345678.90"},
 		// Arabic extended (Persian) digits.
 		{"\u200e$۱۲٬۳۴۵٬۶۷۸٫۹۰", "USD", "fa", "12345678.90"},
 		// Bengali digits.
@@ -386,6 +806,488 @@ func TestFormatter_Parse(t *testing.T) {
 	}
 }
 
+func TestFormatter_ParseTrailingMinus(t *testing.T) {
+	tests := []struct {
+		pattern   string
+		want      string
+		wantError string
+	}{
+		// Only patterns placing the minus sign after the number allow it.
+		{"", "", `invalid number "1234.56-"`},
+		{"¤#,##0.00;-¤#,##0.00", "", `invalid number "1234.56-"`},
+		{"¤#,##0.00;¤#,##0.00-", "-1234.56", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			formatter := currency.NewFormatter(currency.NewLocale("en"))
+			if tt.pattern != "" {
+				if err := formatter.SetPattern(tt.pattern); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			got, err := formatter.Parse("$1,234.56-", "USD")
+			if tt.want != "" && got.Number() != tt.want {
+				t.Errorf("got %v, want %v", got.Number(), tt.want)
+			}
+			errStr := ""
+			if err != nil {
+				errStr = err.Error()
+			}
+			if errStr != tt.wantError {
+				t.Errorf("error: got %v, want %v", errStr, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestFormatter_ParseStrict(t *testing.T) {
+	tests := []struct {
+		s               string
+		currencyCode    string
+		localeID        string
+		accountingStyle bool
+		want            string
+		wantErr         string
+	}{
+		{"$1,234.59", "USD", "en", false, "1234.59", ""},
+		{"USD\u00a01,234,567.59", "USD", "en", false, "1234567.59", ""},
+		{"1234.59", "USD", "en", false, "1234.59", ""},
+		{"-$1,234.59", "USD", "en", false, "-1234.59", ""},
+		{"($1,234.59)", "USD", "en", true, "-1234.59", ""},
+		{"1.234,00\u00a0€", "EUR", "de", false, "1234.00", ""},
+		{"١٢٬٣٤٥٬٦٧٨٫٩٠\u00a0US$", "USD", "ar-EG", false, "12345678.90", ""},
+		{"১,২৩,৪৫,৬৭৮.৯০\u00a0US$", "USD", "bn", false, "12345678.90", ""},
+
+		{"1.2.3,4\u00a0€", "EUR", "de", false, "", `parse "1.2.3,4\u00a0€": invalid digit grouping at position 2`},
+		{"12,34,5", "USD", "en", false, "", `parse "12,34,5": invalid digit grouping at position 3`},
+		{"1,23,456.00", "USD", "en", false, "", `parse "1,23,456.00": invalid digit grouping at position 2`},
+		{"1.5.6", "USD", "en", false, "", `parse "1.5.6": multiple decimal separators at position 3`},
+		{"1.234,5", "USD", "en", false, "", `parse "1.234,5": grouping separator in fraction at position 5`},
+		{"USD 5", "EUR", "en", false, "", `parse "USD 5": mismatched currency code "USD" at position 0`},
+		{"$5", "EUR", "en", false, "", `parse "$5": mismatched currency symbol "$" at position 0`},
+		{"€5€", "EUR", "en", false, "", `parse "€5€": duplicate currency at position 4`},
+		{"(5)", "USD", "en", false, "", `parse "(5)": unexpected character '(' at position 0`},
+		{"(5", "USD", "en", true, "", `parse "(5": missing closing parenthesis at position 2`},
+		{"$", "USD", "en", false, "", `parse "$": missing number at position 1`},
+		{"$-5", "USD", "en", false, "", `parse "$-5": misplaced sign at position 1`},
+		{"$-5", "USD", "en", true, "", `parse "$-5": misplaced sign at position 1`},
+		{"$+5", "USD", "en", false, "", `parse "$+5": misplaced sign at position 1`},
+		{"$(5)", "USD", "en", true, "", `parse "$(5)": misplaced parenthesis at position 1`},
+		{"-5\u00a0€", "EUR", "de", false, "-5", ""},
+		{"€\u00a0-5", "EUR", "nl", false, "-5", ""},
+		{"-€\u00a05", "EUR", "nl", false, "", `parse "-€\u00a05": misplaced sign at position 0`},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			locale := currency.NewLocale(tt.localeID)
+			formatter := currency.NewFormatter(locale)
+			formatter.StrictParsing = true
+			formatter.AccountingStyle = tt.accountingStyle
+			got, err := formatter.Parse(tt.s, tt.currencyCode)
+			if tt.wantErr != "" {
+				if _, ok := err.(currency.ParseError); !ok {
+					t.Fatalf("got %T, want currency.ParseError", err)
+				}
+				if err.Error() != tt.wantErr {
+					t.Errorf("got %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Number() != tt.want {
+				t.Errorf("got %v, want %v", got.Number(), tt.want)
+			}
+			if got.CurrencyCode() != tt.currencyCode {
+				t.Errorf("got %v, want %v", got.CurrencyCode(), tt.currencyCode)
+			}
+		})
+	}
+}
+
+func TestFormatter_ParseAny(t *testing.T) {
+	tests := []struct {
+		s        string
+		localeID string
+		want     string
+	}{
+		{"€12,50", "de", "12.50 EUR"},
+		{"CHF 3.20", "en", "3.20 CHF"},
+		{"12.50 US$", "en", "12.50 USD"},
+		{"-$1,234.59", "en", "-1234.59 USD"},
+		{"$1.234,59", "es-AR", "1234.59 ARS"},
+		{"1.234,59\u00a0€", "de", "1234.59 EUR"},
+		{"₹1,23,456.00", "en-IN", "123456.00 INR"},
+		{"R$ 10,00", "pt", "10.00 BRL"},
+		// The symbol isn't used by the locale, but only matches one currency.
+		{"12.50 zł", "en", "12.50 PLN"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			locale := currency.NewLocale(tt.localeID)
+			formatter := currency.NewFormatter(locale)
+			got, err := formatter.ParseAny(tt.s)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatter_ParseAnyErrors(t *testing.T) {
+	locale := currency.NewLocale("fr")
+	formatter := currency.NewFormatter(locale)
+	_, err := formatter.ParseAny("12,50 $")
+	e, ok := err.(currency.AmbiguousCurrencyError)
+	if !ok {
+		t.Fatalf("got %T, want currency.AmbiguousCurrencyError", err)
+	}
+	if e.Symbol != "$" {
+		t.Errorf("got %v, want $", e.Symbol)
+	}
+	for _, want := range []string{"ARS", "AUD", "CAD", "MXN", "USD"} {
+		found := false
+		for _, candidate := range e.Candidates {
+			if candidate == want {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("candidate %v not found in %v", want, e.Candidates)
+		}
+	}
+
+	// Custom symbols take precedence.
+	formatter.SymbolMap = map[string]string{"USD": "$"}
+	got, err := formatter.ParseAny("12,50 $")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.String() != "12.50 USD" {
+		t.Errorf("got %v, want 12.50 USD", got)
+	}
+
+	tests := []struct {
+		s        string
+		localeID string
+		wantErr  string
+	}{
+		{"12,50", "fr", `parse "12,50": missing currency at position 0`},
+		{"12 USDT", "en", `parse "12 USDT": unknown currency "USDT" at position 3`},
+		{"USD", "en", `parse "USD": missing number at position 3`},
+		// The separators don't match the locale's digit grouping.
+		{"€12,50", "en", `parse "€12,50": invalid digit grouping at position 6`},
+		{"CHF 3.20", "es-AR", `parse "CHF 3.20": invalid digit grouping at position 6`},
+		{"$1,2345.00", "en", `parse "$1,2345.00": invalid digit grouping at position 3`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			formatter := currency.NewFormatter(currency.NewLocale(tt.localeID))
+			_, err := formatter.ParseAny(tt.s)
+			if _, ok := err.(currency.ParseError); !ok {
+				t.Errorf("got %T, want currency.ParseError", err)
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("got %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFormatter_NumberingSystem(t *testing.T) {
+	tests := []struct {
+		number          string
+		currencyCode    string
+		localeID        string
+		numberingSystem currency.NumberingSystem
+		want            string
+	}{
+		{"-1234.59", "USD", "ar", currency.NumLatn, "\u200f\u200e-1,234.59\u00a0US$"},
+		{"1234.59", "USD", "ar-EG", currency.NumLatn, "\u200f1,234.59\u00a0US$"},
+		{"1234.59", "USD", "fa", currency.NumLatn, "\u200e$\u00a01,234.59"},
+		{"1234.59", "USD", "ar-LB", currency.NumLatn, "\u200f1.234,59\u00a0US$"},
+		{"1234.59", "USD", "ps", currency.NumLatn, "$\u00a01.234,59"},
+		{"1234.59", "USD", "kok", currency.NumDeva, "US$१,२३४.५९"},
+		{"-1234.59", "USD", "ar-SA", currency.NumLatn, "\u200f\u200e-1,234.59\u00a0US$"},
+		{"1234.59", "USD", "ar", currency.NumArab, "\u200f١٬٢٣٤٫٥٩\u00a0US$"},
+		{"1234.59", "USD", "fa-AF", currency.NumLatn, "$\u00a01,234.59"},
+		{"1234.59", "USD", "ur-IN", currency.NumLatn, "$1,234.59"},
+		{"1234.59", "USD", "bn", currency.NumLatn, "1,234.59\u00a0US$"},
+		{"1234.59", "USD", "ar-MA", currency.NumArab, "\u200f١٬٢٣٤٫٥٩\u00a0US$"},
+		{"1234.59", "USD", "en", currency.NumArab, "$١٬٢٣٤٫٥٩"},
+		{"1234.59", "USD", "en", currency.NumThai, "$๑,๒๓๔.๕๙"},
+		{"1234.59", "EUR", "de", currency.NumTibt, "༡.༢༣༤,༥༩\u00a0€"},
+		{"1234.59", "USD", "ja", currency.NumFullwide, "$１,２３４.５９"},
+		{"1234.59", "CNY", "zh", currency.NumHanidec, "¥一,二三四.五九"},
+		// Unknown numbering systems are ignored.
+		{"1234.59", "USD", "en", currency.NumberingSystem(99), "$1,234.59"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			amount, _ := currency.NewAmount(tt.number, tt.currencyCode)
+			locale := currency.NewLocale(tt.localeID)
+			formatter := currency.NewFormatter(locale)
+			formatter.NumberingSystem = tt.numberingSystem
+			got := formatter.Format(amount)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+
+			parsed, err := formatter.Parse(got, tt.currencyCode)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if parsed.Number() != tt.number {
+				t.Errorf("got %v, want %v", parsed.Number(), tt.number)
+			}
+		})
+	}
+}
+
+func TestFormatter_DefaultNumberingSystem(t *testing.T) {
+	tests := []struct {
+		localeID string
+		want     currency.NumberingSystem
+	}{
+		{"en", currency.NumLatn},
+		{"ar", currency.NumLatn},
+		{"ar-EG", currency.NumArab},
+		{"ar-MA", currency.NumLatn},
+		{"fa", currency.NumArabExt},
+		{"bn", currency.NumBeng},
+		{"ar-u-nu-latn", currency.NumLatn},
+		{"en-u-nu-thai", currency.NumThai},
+	}
+	for _, tt := range tests {
+		t.Run(tt.localeID, func(t *testing.T) {
+			locale := currency.NewLocale(tt.localeID)
+			formatter := currency.NewFormatter(locale)
+			if formatter.NumberingSystem != tt.want {
+				t.Errorf("got %v, want %v", formatter.NumberingSystem, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatter_SetPattern(t *testing.T) {
+	tests := []struct {
+		pattern      string
+		number       string
+		currencyCode string
+		localeID     string
+		want         string
+	}{
+		{"¤¤ #,##0.00;¤¤ #,##0.00-", "1234.56", "USD", "en", "USD 1,234.56"},
+		{"¤¤ #,##0.00;¤¤ #,##0.00-", "-1234.56", "USD", "en", "USD 1,234.56-"},
+		{"#,##0.00 ¤¤", "1234.56", "EUR", "de", "1.234,56 EUR"},
+		{"#,##0.00 ¤¤", "-1234.56", "EUR", "de", "-1.234,56 EUR"},
+		{"#,##0.00 ¤¤", "1234.56", "EUR", "en", "1,234.56 EUR"},
+		{"¤#,##0.00", "1234.56", "EUR", "fr", "€1\u202f234,56"},
+		{"¤ #,##,##0.00", "1234567.5", "INR", "en", "₹ 12,34,567.50"},
+		{"#0.00 ¤", "1234567.5", "USD", "en", "1234567.50 $"},
+		{"¤#,##0.00;(¤#,##0.00)", "-5", "USD", "en", "($5.00)"},
+		{"'Total:' ¤#,##0.00", "5", "USD", "en", "Total: $5.00"},
+		{"#,##0.00 'o''clock'", "5", "USD", "en", "5.00 o'clock"},
+		// The currency placeholder is optional.
+		{"#,##0.00", "5", "USD", "en", "5.00"},
+		// Letters in the currency code are separated from the number.
+		{"¤¤#,##0.00", "5", "USD", "en", "USD\u00a05.00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			amount, _ := currency.NewAmount(tt.number, tt.currencyCode)
+			locale := currency.NewLocale(tt.localeID)
+			formatter := currency.NewFormatter(locale)
+			if err := formatter.SetPattern(tt.pattern); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := formatter.Format(amount)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+
+			for _, strict := range []bool{false, true} {
+				formatter.StrictParsing = strict
+				parsed, err := formatter.Parse(got, tt.currencyCode)
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				if !parsed.Equal(amount) && parsed.Round().Number() != amount.Round().Number() {
+					t.Errorf("got %v, want %v", parsed, amount)
+				}
+			}
+		})
+	}
+}
+
+func TestFormatter_SetPatternErrors(t *testing.T) {
+	tests := []struct {
+		pattern string
+		wantErr string
+	}{
+		{"¤", `invalid pattern "¤": missing number`},
+		{"¤ 0.00;¤", `invalid pattern "¤ 0.00;¤": missing number`},
+		{"0.00;0.00;0.00", `invalid pattern "0.00;0.00;0.00": multiple pattern separators`},
+		{"¤ 0.00 ¤", `invalid pattern "¤ 0.00 ¤": multiple currency placeholders`},
+		{"¤¤¤ 0.00", `invalid pattern "¤¤¤ 0.00": unsupported currency placeholder ¤¤¤`},
+		{"0.00 0.00", `invalid pattern "0.00 0.00": multiple numbers`},
+		{"#,##0.00%", `invalid pattern "#,##0.00%": unsupported character '%'`},
+		{"#,##0.0.0", `invalid pattern "#,##0.0.0": invalid number "#,##0.0.0"`},
+		{"#,,##0", `invalid pattern "#,,##0": invalid number "#,,##0"`},
+		{"0#.00", `invalid pattern "0#.00": invalid number "0#.00"`},
+		{"'USD 0.00", `invalid pattern "'USD 0.00": unterminated quote`},
+		{"'-'0.00", `invalid pattern "'-'0.00": unsupported literal "-"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			formatter := currency.NewFormatter(currency.NewLocale("en"))
+			err := formatter.SetPattern(tt.pattern)
+			if _, ok := err.(currency.InvalidPatternError); !ok {
+				t.Fatalf("got %T, want currency.InvalidPatternError", err)
+			}
+			if err.Error() != tt.wantErr {
+				t.Errorf("got %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// An invalid pattern doesn't replace the current one.
+	formatter := currency.NewFormatter(currency.NewLocale("en"))
+	amount, _ := currency.NewAmount("5", "USD")
+	formatter.SetPattern("0.00 ¤")
+	formatter.SetPattern("0.00 0.00")
+	if got := formatter.Format(amount); got != "5.00 $" {
+		t.Errorf("got %v, want 5.00 $", got)
+	}
+	// An empty pattern restores the locale's pattern.
+	formatter.SetPattern("")
+	if got := formatter.Format(amount); got != "$5.00" {
+		t.Errorf("got %v, want $5.00", got)
+	}
+}
+
+func TestFormatter_SignificantDigits(t *testing.T) {
+	tests := []struct {
+		number               string
+		currencyCode         string
+		minSignificantDigits uint8
+		maxSignificantDigits uint8
+		roundingMode         currency.RoundingMode
+		want                 string
+	}{
+		{"1234.56", "USD", 0, 2, currency.RoundHalfUp, "$1,200"},
+		{"1254.56", "USD", 0, 2, currency.RoundHalfUp, "$1,300"},
+		{"1254.56", "USD", 0, 2, currency.RoundDown, "$1,200"},
+		{"1234.56", "USD", 0, 5, currency.RoundHalfUp, "$1,234.6"},
+		{"1234.56", "USD", 0, 10, currency.RoundHalfUp, "$1,234.56"},
+		{"0.0012345", "USD", 0, 2, currency.RoundHalfUp, "$0.0012"},
+		{"0.0012355", "USD", 0, 4, currency.RoundHalfUp, "$0.001236"},
+		{"0.0012355", "USD", 0, 4, currency.RoundHalfEven, "$0.001236"},
+		{"0.0012345", "USD", 0, 4, currency.RoundHalfEven, "$0.001234"},
+		{"9.996", "USD", 0, 3, currency.RoundHalfUp, "$10"},
+		{"9.996", "USD", 3, 3, currency.RoundHalfUp, "$10.0"},
+		{"-0.00001234", "EUR", 0, 3, currency.RoundHalfUp, "-€0.0000123"},
+		{"0.0000001", "USD", 0, 2, currency.RoundHalfUp, "$0.0000001"},
+		{"0.000000123456", "USD", 0, 3, currency.RoundHalfUp, "$0.000000123"},
+		{"1.2E+5", "USD", 0, 2, currency.RoundHalfUp, "$120,000"},
+		{"1", "USD", 3, 0, currency.RoundHalfUp, "$1.00"},
+		{"0.5", "USD", 3, 5, currency.RoundHalfUp, "$0.500"},
+		{"123456", "JPY", 3, 5, currency.RoundHalfUp, "¥123,460"},
+		{"0", "USD", 3, 5, currency.RoundHalfUp, "$0.00"},
+		// The minimum is capped by the maximum.
+		{"1.5", "USD", 4, 2, currency.RoundHalfUp, "$1.5"},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			amount, _ := currency.NewAmount(tt.number, tt.currencyCode)
+			formatter := currency.NewFormatter(currency.NewLocale("en"))
+			formatter.MinSignificantDigits = tt.minSignificantDigits
+			formatter.MaxSignificantDigits = tt.maxSignificantDigits
+			formatter.RoundingMode = tt.roundingMode
+			got := formatter.Format(amount)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			got = ""
+			for _, part := range formatter.FormatToParts(amount) {
+				got += part.Value
+			}
+			if got != tt.want {
+				t.Errorf("got %v from parts, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatter_MinIntegerDigits(t *testing.T) {
+	tests := []struct {
+		number           string
+		currencyCode     string
+		localeID         string
+		minIntegerDigits uint8
+		want             string
+	}{
+		{"5", "USD", "en", 3, "$005.00"},
+		{"0.5", "USD", "en", 2, "$00.50"},
+		{"12345", "USD", "en", 3, "$12,345.00"},
+		{"5", "USD", "en", 6, "$000,005.00"},
+		{"-5", "EUR", "de", 3, "-005,00\u00a0€"},
+		{"5", "USD", "ar-EG", 3, "\u200f٠٠٥٫٠٠\u00a0US$"},
+		{"5", "USD", "en", 0, "$5.00"},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			amount, _ := currency.NewAmount(tt.number, tt.currencyCode)
+			formatter := currency.NewFormatter(currency.NewLocale(tt.localeID))
+			formatter.MinIntegerDigits = tt.minIntegerDigits
+			got := formatter.Format(amount)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatter_ResolvedLocale(t *testing.T) {
+	tests := []struct {
+		localeID string
+		want     string
+	}{
+		{"", "en"},
+		{"en-US", "en"},
+		{"de-DE", "de"},
+		{"de-CH", "de-CH"},
+		{"xx", "en"},
+		{"ar-EG-u-nu-latn-cu-usd", "ar-EG-u-nu-latn"},
+		{"en-u-nu-xxxx", "en"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.localeID, func(t *testing.T) {
+			locale := currency.NewLocale(tt.localeID)
+			formatter := currency.NewFormatter(locale)
+			got := formatter.ResolvedLocale().String()
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	formatter := currency.NewFormatter(currency.NewLocale("ar-EG"))
+	formatter.NumberingSystem = currency.NumLatn
+	got := formatter.ResolvedLocale().String()
+	if got != "ar-EG-u-nu-latn" {
+		t.Errorf("got %v, want ar-EG-u-nu-latn", got)
+	}
+}
+
 func TestEmptyLocale(t *testing.T) {
 	locale := currency.NewLocale("")
 	formatter := currency.NewFormatter(locale)
@@ -7,9 +7,11 @@
 package main
 
 import (
+	"bytes"
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
+	"go/format"
 	"io"
 	"log"
 	"net/http"
@@ -28,6 +30,9 @@ import (
 
 const assetDir = "raw"
 
+// cldrVersion is the pinned CLDR release, matching a tag of the cldr-json repository.
+const cldrVersion = "48.0.0"
+
 const dataTemplate = `// Code generated by go generate; DO NOT EDIT.
 //go:generate go run gen.go
 
@@ -36,22 +41,16 @@ package currency
 // CLDRVersion is the CLDR version from which the data is derived.
 const CLDRVersion = "{{ .CLDRVersion }}"
 
-type numberingSystem uint8
-
-const (
-	numLatn numberingSystem = iota
-	numArab
-	numArabExt
-	numBeng
-	numDeva
-	numMymr
-)
-
 type currencyInfo struct {
 	numericCode string
 	digits      uint8
 }
 
+type cashInfo struct {
+	digits   uint8
+	rounding uint8
+}
+
 type symbolInfo struct {
 	symbol  string
 	locales []string
@@ -60,7 +59,7 @@ type symbolInfo struct {
 type currencyFormat struct {
 	standardPattern       string
 	accountingPattern     string
-	numberingSystem       numberingSystem
+	numberingSystem       NumberingSystem
 	minGroupingDigits     uint8
 	primaryGroupingSize   uint8
 	secondaryGroupingSize uint8
@@ -70,6 +69,11 @@ type currencyFormat struct {
 	minusSign             string
 }
 
+type rangeFormat struct {
+	rangePattern         string
+	approximatelyPattern string
+}
+
 // Defined separately to ensure consistent ordering (G10, then others).
 var currencyCodes = []string{
 	// G10 currencies https://en.wikipedia.org/wiki/G10_currencies.
@@ -83,6 +87,10 @@ var currencies = map[string]currencyInfo{
 	{{ export .CurrencyInfo 3 "\t" }}
 }
 
+var cashRoundings = map[string]cashInfo{
+	{{ export .CashInfo 3 "\t" }}
+}
+
 var currencySymbols = map[string][]symbolInfo{
 	{{ export .SymbolInfo 1 "\t" }}
 }
@@ -91,6 +99,18 @@ var currencyFormats = map[string]currencyFormat{
 	{{ export .Formats 1 "\t" }}
 }
 
+var numberingSystemFormats = map[string]map[NumberingSystem]currencyFormat{
+	{{ export .NSFormats 1 "\t" }}
+}
+
+var compactFormats = map[string][]string{
+	{{ export .CompactFormats 1 "\t" }}
+}
+
+var rangeFormats = map[string]rangeFormat{
+	{{ export .RangeFormats 1 "\t" }}
+}
+
 var countryCurrencies = map[string]string{
 	{{ export .CountryCurrencies 5 "\t" }}
 }
@@ -100,6 +120,29 @@ var parentLocales = map[string]string{
 }
 `
 
+const namesTemplate = `// Code generated by go generate; DO NOT EDIT.
+
+//go:build currency_names
+// +build currency_names
+
+package currency
+
+var currencyNames = map[string]map[string]nameInfo{
+	{{ export .Names 1 "\t" }}
+}
+
+var currencyUnitPatterns = map[string]string{
+	{{ export .UnitPatterns 5 "\t" }}
+}
+
+var pluralRules = map[string][]pluralRule{
+	{{ export .PluralRules 1 "\t" }}
+}
+`
+
+// pluralCategories lists the CLDR plural categories, in the order used by CLDR.
+var pluralCategories = []string{"zero", "one", "two", "few", "many", "other"}
+
 type currencyInfo struct {
 	numericCode string
 	digits      uint8
@@ -109,6 +152,15 @@ func (c currencyInfo) GoString() string {
 	return fmt.Sprintf("{%q, %d}", c.numericCode, int(c.digits))
 }
 
+type cashInfo struct {
+	digits   uint8
+	rounding uint8
+}
+
+func (c cashInfo) GoString() string {
+	return fmt.Sprintf("{%d, %d}", int(c.digits), int(c.rounding))
+}
+
 type symbolInfo struct {
 	symbol  string
 	locales []string
@@ -132,21 +184,62 @@ func (ss symbolInfoSlice) GoString() string {
 	return b.String()
 }
 
-type numberingSystem uint8
+type rangeFormat struct {
+	rangePattern         string
+	approximatelyPattern string
+}
+
+func (r rangeFormat) GoString() string {
+	return fmt.Sprintf("{%q, %q}", r.rangePattern, r.approximatelyPattern)
+}
+
+type NumberingSystem uint8
 
 const (
-	numLatn numberingSystem = iota
-	numArab
-	numArabExt
-	numBeng
-	numDeva
-	numMymr
+	NumLatn NumberingSystem = iota
+	NumArab
+	NumArabExt
+	NumBeng
+	NumDeva
+	NumMymr
+	NumThai
+	NumTibt
+	NumFullwide
+	NumHanidec
 )
 
+var numberingSystems = map[string]NumberingSystem{
+	"latn":     NumLatn,
+	"arab":     NumArab,
+	"arabext":  NumArabExt,
+	"beng":     NumBeng,
+	"deva":     NumDeva,
+	"mymr":     NumMymr,
+	"thai":     NumThai,
+	"tibt":     NumTibt,
+	"fullwide": NumFullwide,
+	"hanidec":  NumHanidec,
+}
+
+func (n NumberingSystem) GoString() string {
+	names := []string{
+		"NumLatn", "NumArab", "NumArabExt", "NumBeng", "NumDeva",
+		"NumMymr", "NumThai", "NumTibt", "NumFullwide", "NumHanidec",
+	}
+	if int(n) < len(names) {
+		return names[n]
+	}
+	return fmt.Sprintf("%d", n)
+}
+
+func (n NumberingSystem) isArabic() bool {
+	return n == NumArab || n == NumArabExt
+}
+
 type currencyFormat struct {
 	standardPattern       string
 	accountingPattern     string
-	numberingSystem       numberingSystem
+	numberingSystem       NumberingSystem
 	minGroupingDigits     uint8
 	primaryGroupingSize   uint8
 	secondaryGroupingSize uint8
@@ -157,7 +250,96 @@ type currencyFormat struct {
 }
 
 func (f currencyFormat) GoString() string {
-	return fmt.Sprintf("{%q, %q, %d, %d, %d, %d, %q, %q, %q, %q}", f.standardPattern, f.accountingPattern, f.numberingSystem, f.minGroupingDigits, f.primaryGroupingSize, f.secondaryGroupingSize, f.decimalSeparator, f.groupingSeparator, f.plusSign, f.minusSign)
+	return fmt.Sprintf("{%q, %q, %d, %d, %d, %d, %q, %q, %q, %q}", f.standardPattern, f.accountingPattern, int(f.numberingSystem), f.minGroupingDigits, f.primaryGroupingSize, f.secondaryGroupingSize, f.decimalSeparator, f.groupingSeparator, f.plusSign, f.minusSign)
+}
+
+// withNumberingSystem returns the format as used at runtime for a numbering
+// system without its own data (see getNumberingSystemFormat).
+func (f currencyFormat) withNumberingSystem(numSystem NumberingSystem) currencyFormat {
+	if numSystem.isArabic() && !f.numberingSystem.isArabic() {
+		f.decimalSeparator = "٫"
+		if f.groupingSeparator != "" {
+			f.groupingSeparator = "٬"
+		}
+	} else if !numSystem.isArabic() && f.numberingSystem.isArabic() {
+		f.decimalSeparator = "."
+		if f.groupingSeparator != "" {
+			f.groupingSeparator = ","
+		}
+	}
+	f.numberingSystem = numSystem
+
+	return f
+}
+
+type numberingSystemFormats map[NumberingSystem]currencyFormat
+
+func (nf numberingSystemFormats) GoString() string {
+	var numSystems []NumberingSystem
+	for numSystem := range nf {
+		numSystems = append(numSystems, numSystem)
+	}
+	sort.Slice(numSystems, func(i, j int) bool {
+		return numSystems[i] < numSystems[j]
+	})
+
+	b := strings.Builder{}
+	b.WriteString("{\n")
+	for _, numSystem := range numSystems {
+		fmt.Fprintf(&b, "\t\t%#v: %#v,\n", numSystem, nf[numSystem])
+	}
+	b.WriteString("\t}")
+
+	return b.String()
+}
+
+type nameInfo struct {
+	displayName string
+	plurals     map[string]string
+}
+
+func (n nameInfo) GoString() string {
+	var plurals []string
+	for _, category := range pluralCategories {
+		if name, ok := n.plurals[category]; ok {
+			plurals = append(plurals, fmt.Sprintf("%q: %q", category, name))
+		}
+	}
+	return fmt.Sprintf("{%q, map[string]string{%v}}", n.displayName, strings.Join(plurals, ", "))
+}
+
+type localeNames map[string]nameInfo
+
+func (ln localeNames) GoString() string {
+	var currencyCodes []string
+	for currencyCode := range ln {
+		currencyCodes = append(currencyCodes, currencyCode)
+	}
+	sort.Strings(currencyCodes)
+
+	b := strings.Builder{}
+	b.WriteString("{\n")
+	for _, currencyCode := range currencyCodes {
+		fmt.Fprintf(&b, "\t\t%q: %#v,\n", currencyCode, ln[currencyCode])
+	}
+	b.WriteString("\t}")
+
+	return b.String()
+}
+
+type pluralRule struct {
+	category  string
+	condition string
+}
+
+type pluralRuleSlice []pluralRule
+
+func (ps pluralRuleSlice) GoString() string {
+	rules := make([]string, len(ps))
+	for i, p := range ps {
+		rules[i] = fmt.Sprintf("{%q, %q}", p.category, p.condition)
+	}
+	return "{" + strings.Join(rules, ", ") + "}"
 }
 
 func main() {
@@ -187,12 +369,27 @@ func main() {
 		os.RemoveAll(assetDir)
 		log.Fatal(err)
 	}
+	cashRoundings, err := generateCashRoundings(currencies, assetDir)
+	if err != nil {
+		os.RemoveAll(assetDir)
+		log.Fatal(err)
+	}
 	symbols, err := generateSymbols(currencies, assetDir)
 	if err != nil {
 		os.RemoveAll(assetDir)
 		log.Fatal(err)
 	}
-	formats, err := generateFormats(assetDir)
+	formats, nsFormats, err := generateFormats(assetDir)
+	if err != nil {
+		os.RemoveAll(assetDir)
+		log.Fatal(err)
+	}
+	compactFormats, err := generateCompactFormats(assetDir)
+	if err != nil {
+		os.RemoveAll(assetDir)
+		log.Fatal(err)
+	}
+	rangeFormats, err := generateRangeFormats(assetDir)
 	if err != nil {
 		os.RemoveAll(assetDir)
 		log.Fatal(err)
@@ -207,6 +404,21 @@ func main() {
 		os.RemoveAll(assetDir)
 		log.Fatal(err)
 	}
+	names, err := generateNames(currencies, assetDir)
+	if err != nil {
+		os.RemoveAll(assetDir)
+		log.Fatal(err)
+	}
+	unitPatterns, err := generateUnitPatterns(names, assetDir)
+	if err != nil {
+		os.RemoveAll(assetDir)
+		log.Fatal(err)
+	}
+	pluralRules, err := generatePluralRules(names, assetDir)
+	if err != nil {
+		os.RemoveAll(assetDir)
+		log.Fatal(err)
+	}
 
 	var currencyCodes []string
 	for currencyCode := range currencies {
@@ -224,29 +436,17 @@ func main() {
 		}
 	}
 
-	os.Remove("data.go")
-	f, err := os.Create("data.go")
-	if err != nil {
-		os.RemoveAll(assetDir)
-		log.Fatal(err)
-	}
-	defer f.Close()
-
-	funcMap := template.FuncMap{
-		"export": export,
-	}
-	t, err := template.New("data").Funcs(funcMap).Parse(dataTemplate)
-	if err != nil {
-		os.RemoveAll(assetDir)
-		log.Fatal(err)
-	}
-	t.Execute(f, struct {
+	err = writeTemplate("data.go", dataTemplate, struct {
 		CLDRVersion       string
 		G10Currencies     []string
 		OtherCurrencies   []string
 		CurrencyInfo      map[string]*currencyInfo
+		CashInfo          map[string]cashInfo
 		SymbolInfo        map[string]symbolInfoSlice
 		Formats           map[string]currencyFormat
+		NSFormats         map[string]numberingSystemFormats
+		CompactFormats    map[string][]string
+		RangeFormats      map[string]rangeFormat
 		CountryCurrencies map[string]string
 		ParentLocales     map[string]string
 	}{
@@ -254,22 +454,67 @@ func main() {
 		G10Currencies:     g10Currencies,
 		OtherCurrencies:   otherCurrencies,
 		CurrencyInfo:      currencies,
+		CashInfo:          cashRoundings,
 		SymbolInfo:        symbols,
 		Formats:           formats,
+		NSFormats:         nsFormats,
+		CompactFormats:    compactFormats,
+		RangeFormats:      rangeFormats,
 		CountryCurrencies: countryCurrencies,
 		ParentLocales:     parentLocales,
 	})
+	if err != nil {
+		os.RemoveAll(assetDir)
+		log.Fatal(err)
+	}
+
+	// Names are generated into a separate file, which is only included
+	// when building with the "currency_names" tag.
+	err = writeTemplate("data_names.go", namesTemplate, struct {
+		Names        map[string]localeNames
+		UnitPatterns map[string]string
+		PluralRules  map[string]pluralRuleSlice
+	}{
+		Names:        names,
+		UnitPatterns: unitPatterns,
+		PluralRules:  pluralRules,
+	})
+	if err != nil {
+		os.RemoveAll(assetDir)
+		log.Fatal(err)
+	}
 
 	log.Println("Done.")
 }
 
-// fetchCLDR fetches the CLDR data from GitHub and returns its version.
+// writeTemplate executes the template and writes the gofmt-ed result to the given file.
+func writeTemplate(filename string, text string, data interface{}) error {
+	funcMap := template.FuncMap{
+		"export": export,
+	}
+	t, err := template.New(filename).Funcs(funcMap).Parse(text)
+	if err != nil {
+		return fmt.Errorf("writeTemplate: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return fmt.Errorf("writeTemplate: %w", err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("writeTemplate: %w", err)
+	}
+
+	return os.WriteFile(filename, src, 0644)
+}
+
+// fetchCLDR fetches the pinned CLDR release from GitHub and returns its version.
 //
 // The JSON version of the data is used because it is more convenient
 // to parse. See https://github.com/unicode-org/cldr-json for details.
 func fetchCLDR(dir string) (string, error) {
 	repo := "https://github.com/unicode-org/cldr-json.git"
-	cmd := exec.Command("git", "clone", repo, "--depth", "1", dir)
+	cmd := exec.Command("git", "clone", repo, "--depth", "1", "--branch", cldrVersion, dir)
 	cmd.Stderr = os.Stderr
 	_, err := cmd.Output()
 	if err != nil {
@@ -286,6 +531,9 @@ func fetchCLDR(dir string) (string, error) {
 	if err := json.Unmarshal(data, &aux); err != nil {
 		return "", fmt.Errorf("fetchCLDR: %w", err)
 	}
+	if aux.Version != cldrVersion {
+		return "", fmt.Errorf("fetchCLDR: got version %q, want %q", aux.Version, cldrVersion)
+	}
 
 	return aux.Version, nil
 }
@@ -385,6 +633,53 @@ func replaceDigits(currencies map[string]*currencyInfo, dir string) error {
 	return nil
 }
 
+// generateCashRoundings generates the cash rounding rules from CLDR data.
+//
+// Only currencies whose cash rounding differs from their regular
+// rounding are included (e.g. CHF, which is rounded to 0.05 in cash).
+func generateCashRoundings(currencies map[string]*currencyInfo, dir string) (map[string]cashInfo, error) {
+	data, err := os.ReadFile(dir + "/cldr-json/cldr-core/supplemental/currencyData.json")
+	if err != nil {
+		return nil, fmt.Errorf("generateCashRoundings: %w", err)
+	}
+	aux := struct {
+		Supplemental struct {
+			CurrencyData struct {
+				Fractions map[string]map[string]string
+			}
+		}
+	}{}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return nil, fmt.Errorf("generateCashRoundings: %w", err)
+	}
+
+	cashRoundings := make(map[string]cashInfo)
+	for currencyCode, info := range currencies {
+		fractions, ok := aux.Supplemental.CurrencyData.Fractions[currencyCode]
+		if !ok {
+			continue
+		}
+		_, hasCashDigits := fractions["_cashDigits"]
+		_, hasCashRounding := fractions["_cashRounding"]
+		if !hasCashDigits && !hasCashRounding {
+			continue
+		}
+		cashDigits := parseDigits(fractions["_cashDigits"], info.digits)
+		cashRounding := parseDigits(fractions["_cashRounding"], 0)
+		// CLDR uses 0 to indicate that there is no rounding increment,
+		// which is the same as an increment of a single minor unit.
+		if cashRounding == 0 {
+			cashRounding = 1
+		}
+		if cashDigits == info.digits && cashRounding == 1 {
+			continue
+		}
+		cashRoundings[currencyCode] = cashInfo{cashDigits, cashRounding}
+	}
+
+	return cashRoundings, nil
+}
+
 // generateCountryCurrencies generates the map of country codes to currency codes.
 func generateCountryCurrencies(dir string) (map[string]string, error) {
 	data, err := os.ReadFile(dir + "/cldr-json/cldr-core/supplemental/currencyData.json")
@@ -588,23 +883,35 @@ func readSymbols(currencies map[string]*currencyInfo, dir string, locale string)
 
 // generateFormats generates currency formats from CLDR data.
 //
-// Formats are deduplicated by parent.
-func generateFormats(dir string) (map[string]currencyFormat, error) {
+// Returns the formats for each locale's default numbering system, and the
+// formats for other numbering systems, when they differ from the default
+// format (ignoring digits). Formats are deduplicated by parent.
+func generateFormats(dir string) (map[string]currencyFormat, map[string]numberingSystemFormats, error) {
 	formats := make(map[string]currencyFormat)
+	nsFormats := make(map[string]numberingSystemFormats)
 	files, err := os.ReadDir(dir + "/cldr-json/cldr-numbers-modern/main")
 	if err != nil {
-		return nil, fmt.Errorf("generateFormats: %w", err)
+		return nil, nil, fmt.Errorf("generateFormats: %w", err)
 	}
 	for _, file := range files {
 		locale := file.Name()
 		if shouldIgnoreLocale(locale) {
 			continue
 		}
-		format, err := readFormat(dir, locale)
+		format, otherFormats, err := readFormat(dir, locale)
 		if err != nil {
-			return nil, fmt.Errorf("generateFormats: %w", err)
+			return nil, nil, fmt.Errorf("generateFormats: %w", err)
 		}
 		formats[locale] = format
+		for numSystem, otherFormat := range otherFormats {
+			if otherFormat == format.withNumberingSystem(numSystem) {
+				continue
+			}
+			if nsFormats[locale] == nil {
+				nsFormats[locale] = make(numberingSystemFormats)
+			}
+			nsFormats[locale][numSystem] = otherFormat
+		}
 	}
 
 	// Remove formats which are identical to their parents.
@@ -616,89 +923,82 @@ func generateFormats(dir string) (map[string]currencyFormat, error) {
 			deleteLocales = append(deleteLocales, localeID)
 		}
 	}
+	for localeID, otherFormats := range nsFormats {
+		locale := currency.NewLocale(localeID)
+		parentID := locale.GetParent().String()
+		if parentID != "" && reflect.DeepEqual(otherFormats, nsFormats[parentID]) {
+			delete(nsFormats, localeID)
+		}
+	}
 	for _, localeID := range deleteLocales {
 		delete(formats, localeID)
 	}
 
-	return formats, nil
+	return formats, nsFormats, nil
 }
 
-// readFormat reads the given locale's currency format from CLDR data.
-func readFormat(dir string, locale string) (currencyFormat, error) {
+// readFormat reads the given locale's currency formats from CLDR data.
+//
+// Returns the format for the default numbering system, and the formats
+// for the other numbering systems that have data.
+func readFormat(dir string, locale string) (currencyFormat, map[NumberingSystem]currencyFormat, error) {
 	filename := fmt.Sprintf("%v/cldr-json/cldr-numbers-modern/main/%v/numbers.json", dir, locale)
 	data, err := os.ReadFile(filename)
 	if err != nil {
-		return currencyFormat{}, fmt.Errorf("readFormat: %w", err)
+		return currencyFormat{}, nil, fmt.Errorf("readFormat: %w", err)
 	}
 
 	type cldrPattern struct {
 		Standard   string
 		Accounting string
 	}
-	type cldrData struct {
-		Numbers struct {
-			MinimumGroupingDigits  string
-			DefaultNumberingSystem string
-			PatternLatn            cldrPattern       `json:"currencyFormats-numberSystem-latn"`
-			PatternArab            cldrPattern       `json:"currencyFormats-numberSystem-arab"`
-			PatternArabExt         cldrPattern       `json:"currencyFormats-numberSystem-arabext"`
-			PatternBeng            cldrPattern       `json:"currencyFormats-numberSystem-beng"`
-			PatternDeva            cldrPattern       `json:"currencyFormats-numberSystem-deva"`
-			PatternMymr            cldrPattern       `json:"currencyFormats-numberSystem-mymr"`
-			SymbolsLatn            map[string]string `json:"symbols-numberSystem-latn"`
-			SymbolsArab            map[string]string `json:"symbols-numberSystem-arab"`
-			SymbolsArabExt         map[string]string `json:"symbols-numberSystem-arabext"`
-			SymbolsBeng            map[string]string `json:"symbols-numberSystem-beng"`
-			SymbolsDeva            map[string]string `json:"symbols-numberSystem-deva"`
-			SymbolsMymr            map[string]string `json:"symbols-numberSystem-mymr"`
-		}
-	}
 	aux := struct {
-		Main map[string]cldrData
+		Main map[string]struct {
+			Numbers map[string]json.RawMessage
+		}
 	}{}
 	if err := json.Unmarshal(data, &aux); err != nil {
-		return currencyFormat{}, fmt.Errorf("readFormat: %w", err)
-	}
-
-	var numSystem numberingSystem
-	var standardPattern string
-	var accountingPattern string
-	var symbols map[string]string
-	extFormat := aux.Main[locale].Numbers
-	switch extFormat.DefaultNumberingSystem {
-	case "latn":
-		numSystem = numLatn
-		standardPattern = extFormat.PatternLatn.Standard
-		accountingPattern = extFormat.PatternLatn.Accounting
-		symbols = extFormat.SymbolsLatn
-	case "arab":
-		numSystem = numArab
-		standardPattern = extFormat.PatternArab.Standard
-		accountingPattern = extFormat.PatternArab.Accounting
-		symbols = extFormat.SymbolsArab
-	case "arabext":
-		numSystem = numArabExt
-		standardPattern = extFormat.PatternArabExt.Standard
-		accountingPattern = extFormat.PatternArabExt.Accounting
-		symbols = extFormat.SymbolsArabExt
-	case "beng":
-		numSystem = numBeng
-		standardPattern = extFormat.PatternBeng.Standard
-		accountingPattern = extFormat.PatternBeng.Accounting
-		symbols = extFormat.SymbolsBeng
-	case "deva":
-		numSystem = numDeva
-		standardPattern = extFormat.PatternDeva.Standard
-		accountingPattern = extFormat.PatternDeva.Accounting
-		symbols = extFormat.SymbolsDeva
-	case "mymr":
-		numSystem = numMymr
-		standardPattern = extFormat.PatternMymr.Standard
-		accountingPattern = extFormat.PatternMymr.Accounting
-		symbols = extFormat.SymbolsMymr
-	default:
-		return currencyFormat{}, fmt.Errorf("readFormat: unknown numbering system %q in locale %q", extFormat.DefaultNumberingSystem, locale)
+		return currencyFormat{}, nil, fmt.Errorf("readFormat: %w", err)
 	}
+	numbers := aux.Main[locale].Numbers
+	var defaultID string
+	var minGroupingDigits string
+	json.Unmarshal(numbers["defaultNumberingSystem"], &defaultID)
+	json.Unmarshal(numbers["minimumGroupingDigits"], &minGroupingDigits)
+	if _, ok := numberingSystems[defaultID]; !ok {
+		return currencyFormat{}, nil, fmt.Errorf("readFormat: unknown numbering system %q in locale %q", defaultID, locale)
+	}
+
+	var defaultFormat currencyFormat
+	otherFormats := make(map[NumberingSystem]currencyFormat)
+	for id, numSystem := range numberingSystems {
+		patternData, ok := numbers["currencyFormats-numberSystem-"+id]
+		if !ok {
+			continue
+		}
+		var pattern cldrPattern
+		var symbols map[string]string
+		if err := json.Unmarshal(patternData, &pattern); err != nil {
+			return currencyFormat{}, nil, fmt.Errorf("readFormat: %w", err)
+		}
+		if symbolData, ok := numbers["symbols-numberSystem-"+id]; ok {
+			if err := json.Unmarshal(symbolData, &symbols); err != nil {
+				return currencyFormat{}, nil, fmt.Errorf("readFormat: %w", err)
+			}
+		}
+		format := newFormat(pattern.Standard, pattern.Accounting, symbols, numSystem, parseDigits(minGroupingDigits, 1))
+		if id == defaultID {
+			defaultFormat = format
+		} else {
+			otherFormats[numSystem] = format
+		}
+	}
+
+	return defaultFormat, otherFormats, nil
+}
+
+// newFormat creates a currency format from CLDR patterns and symbols.
+func newFormat(standardPattern, accountingPattern string, symbols map[string]string, numSystem NumberingSystem, minGroupingDigits uint8) currencyFormat {
 	primaryGroupingSize := 0
 	secondaryGroupingSize := 0
 	patternParts := strings.Split(standardPattern, ";")
@@ -736,7 +1036,7 @@ func readFormat(dir string, locale string) (currencyFormat, error) {
 	format.standardPattern = standardPattern
 	format.accountingPattern = accountingPattern
 	format.numberingSystem = numSystem
-	format.minGroupingDigits = parseDigits(extFormat.MinimumGroupingDigits, 1)
+	format.minGroupingDigits = minGroupingDigits
 	format.primaryGroupingSize = uint8(primaryGroupingSize)
 	format.secondaryGroupingSize = uint8(secondaryGroupingSize)
 	format.decimalSeparator = decimalSeparator
@@ -744,7 +1044,183 @@ func readFormat(dir string, locale string) (currencyFormat, error) {
 	format.plusSign = symbols["plusSign"]
 	format.minusSign = symbols["minusSign"]
 
-	return format, nil
+	return format
+}
+
+// generateCompactFormats generates compact currency formats from CLDR data.
+//
+// Formats are deduplicated by parent.
+func generateCompactFormats(dir string) (map[string][]string, error) {
+	compactFormats := make(map[string][]string)
+	files, err := os.ReadDir(dir + "/cldr-json/cldr-numbers-modern/main")
+	if err != nil {
+		return nil, fmt.Errorf("generateCompactFormats: %w", err)
+	}
+	for _, file := range files {
+		locale := file.Name()
+		if shouldIgnoreLocale(locale) {
+			continue
+		}
+		patterns, err := readCompactFormat(dir, locale)
+		if err != nil {
+			return nil, fmt.Errorf("generateCompactFormats: %w", err)
+		}
+		if patterns != nil {
+			compactFormats[locale] = patterns
+		}
+	}
+
+	// Remove formats which are identical to their parents.
+	var deleteLocales []string
+	for localeID, patterns := range compactFormats {
+		locale := currency.NewLocale(localeID)
+		parentID := locale.GetParent().String()
+		if parentID != "" && reflect.DeepEqual(patterns, compactFormats[parentID]) {
+			deleteLocales = append(deleteLocales, localeID)
+		}
+	}
+	for _, localeID := range deleteLocales {
+		delete(compactFormats, localeID)
+	}
+
+	return compactFormats, nil
+}
+
+// readCompactFormat reads the given locale's compact currency patterns from CLDR data.
+//
+// Returns one pattern per magnitude, from 10^3 to 10^14.
+// Only the "other" plural form is used, since currency patterns
+// almost never differ between plural forms.
+func readCompactFormat(dir string, locale string) ([]string, error) {
+	filename := fmt.Sprintf("%v/cldr-json/cldr-numbers-modern/main/%v/numbers.json", dir, locale)
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("readCompactFormat: %w", err)
+	}
+
+	type cldrPattern struct {
+		Short struct {
+			Standard map[string]string
+		}
+	}
+	aux := struct {
+		Main map[string]struct {
+			Numbers map[string]json.RawMessage
+		}
+	}{}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return nil, fmt.Errorf("readCompactFormat: %w", err)
+	}
+	numbers := aux.Main[locale].Numbers
+	var numSystem string
+	if err := json.Unmarshal(numbers["defaultNumberingSystem"], &numSystem); err != nil {
+		return nil, fmt.Errorf("readCompactFormat: %w", err)
+	}
+	rawPattern, ok := numbers["currencyFormats-numberSystem-"+numSystem]
+	if !ok {
+		return nil, nil
+	}
+	pattern := cldrPattern{}
+	if err := json.Unmarshal(rawPattern, &pattern); err != nil {
+		return nil, fmt.Errorf("readCompactFormat: %w", err)
+	}
+	if len(pattern.Short.Standard) == 0 {
+		return nil, nil
+	}
+
+	patterns := make([]string, 0, 12)
+	magnitude := "1000"
+	for i := 3; i <= 14; i++ {
+		p, ok := pattern.Short.Standard[magnitude+"-count-other"]
+		if !ok {
+			return nil, fmt.Errorf("readCompactFormat: missing %v pattern in locale %q", magnitude, locale)
+		}
+		patterns = append(patterns, processCompactPattern(p))
+		magnitude += "0"
+	}
+
+	return patterns, nil
+}
+
+// generateRangeFormats generates range formats from CLDR data.
+//
+// Formats are deduplicated by parent.
+func generateRangeFormats(dir string) (map[string]rangeFormat, error) {
+	rangeFormats := make(map[string]rangeFormat)
+	files, err := os.ReadDir(dir + "/cldr-json/cldr-numbers-modern/main")
+	if err != nil {
+		return nil, fmt.Errorf("generateRangeFormats: %w", err)
+	}
+	for _, file := range files {
+		locale := file.Name()
+		if shouldIgnoreLocale(locale) {
+			continue
+		}
+		rangeFormat, err := readRangeFormat(dir, locale)
+		if err != nil {
+			return nil, fmt.Errorf("generateRangeFormats: %w", err)
+		}
+		rangeFormats[locale] = rangeFormat
+	}
+
+	// Remove formats which are identical to their parents.
+	var deleteLocales []string
+	for localeID, rangeFormat := range rangeFormats {
+		locale := currency.NewLocale(localeID)
+		parentID := locale.GetParent().String()
+		if parentID != "" && rangeFormat == rangeFormats[parentID] {
+			deleteLocales = append(deleteLocales, localeID)
+		}
+	}
+	for _, localeID := range deleteLocales {
+		delete(rangeFormats, localeID)
+	}
+
+	return rangeFormats, nil
+}
+
+// readRangeFormat reads the given locale's range and approximately patterns from CLDR data.
+func readRangeFormat(dir string, locale string) (rangeFormat, error) {
+	filename := fmt.Sprintf("%v/cldr-json/cldr-numbers-modern/main/%v/numbers.json", dir, locale)
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return rangeFormat{}, fmt.Errorf("readRangeFormat: %w", err)
+	}
+
+	aux := struct {
+		Main map[string]struct {
+			Numbers map[string]json.RawMessage
+		}
+	}{}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return rangeFormat{}, fmt.Errorf("readRangeFormat: %w", err)
+	}
+	numbers := aux.Main[locale].Numbers
+	var numSystem string
+	if err := json.Unmarshal(numbers["defaultNumberingSystem"], &numSystem); err != nil {
+		return rangeFormat{}, fmt.Errorf("readRangeFormat: %w", err)
+	}
+	var patterns map[string]string
+	if err := json.Unmarshal(numbers["miscPatterns-numberSystem-"+numSystem], &patterns); err != nil {
+		return rangeFormat{}, fmt.Errorf("readRangeFormat: %w", err)
+	}
+	if patterns["range"] == "" || patterns["approximately"] == "" {
+		return rangeFormat{}, fmt.Errorf("readRangeFormat: missing patterns in locale %q", locale)
+	}
+
+	return rangeFormat{patterns["range"], patterns["approximately"]}, nil
+}
+
+// processCompactPattern processes the compact pattern.
+func processCompactPattern(pattern string) string {
+	// Only the positive pattern is used, the sign is added separately.
+	pattern = strings.Split(pattern, ";")[0]
+	// Unquote literals, such as the period in "0 Mio'.' ¤".
+	pattern = strings.ReplaceAll(pattern, "''", "\x00")
+	pattern = strings.ReplaceAll(pattern, "'", "")
+	pattern = strings.ReplaceAll(pattern, "\x00", "'")
+
+	return pattern
 }
 
 // processPattern processes the pattern.
@@ -756,6 +1232,189 @@ func processPattern(pattern string) string {
 	return pattern
 }
 
+// generateNames generates currency display names from CLDR data.
+//
+// Names are deduplicated by parent, per currency.
+func generateNames(currencies map[string]*currencyInfo, dir string) (map[string]localeNames, error) {
+	names := make(map[string]localeNames)
+	files, err := os.ReadDir(dir + "/cldr-json/cldr-numbers-modern/main")
+	if err != nil {
+		return nil, fmt.Errorf("generateNames: %w", err)
+	}
+	for _, file := range files {
+		locale := file.Name()
+		if shouldIgnoreLocale(locale) {
+			continue
+		}
+		localNames, err := readNames(currencies, dir, locale)
+		if err != nil {
+			return nil, fmt.Errorf("generateNames: %w", err)
+		}
+		names[locale] = localNames
+	}
+
+	// Remove names which are identical to their parents.
+	// Must be done in two passes, since parents are also being deduplicated.
+	deleteNames := make(map[string][]string)
+	for localeID, localNames := range names {
+		locale := currency.NewLocale(localeID)
+		parentID := locale.GetParent().String()
+		if parentID == "" {
+			continue
+		}
+		for currencyCode, name := range localNames {
+			if reflect.DeepEqual(name, names[parentID][currencyCode]) {
+				deleteNames[localeID] = append(deleteNames[localeID], currencyCode)
+			}
+		}
+	}
+	for localeID, currencyCodes := range deleteNames {
+		for _, currencyCode := range currencyCodes {
+			delete(names[localeID], currencyCode)
+		}
+		if len(names[localeID]) == 0 {
+			delete(names, localeID)
+		}
+	}
+
+	return names, nil
+}
+
+// readNames reads the given locale's currency names from CLDR data.
+//
+// Discards names belonging to inactive currencies.
+func readNames(currencies map[string]*currencyInfo, dir string, locale string) (localeNames, error) {
+	filename := fmt.Sprintf("%v/cldr-json/cldr-numbers-modern/main/%v/currencies.json", dir, locale)
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("readNames: %w", err)
+	}
+
+	type cldrData struct {
+		Numbers struct {
+			Currencies map[string]map[string]string
+		}
+	}
+	aux := struct {
+		Main map[string]cldrData
+	}{}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return nil, fmt.Errorf("readNames: %w", err)
+	}
+
+	names := make(localeNames)
+	for currencyCode, data := range aux.Main[locale].Numbers.Currencies {
+		if _, ok := currencies[currencyCode]; !ok || data["displayName"] == "" {
+			continue
+		}
+		name := nameInfo{
+			displayName: data["displayName"],
+			plurals:     make(map[string]string),
+		}
+		for _, category := range pluralCategories {
+			if pluralName, ok := data["displayName-count-"+category]; ok {
+				name.plurals[category] = pluralName
+			}
+		}
+		names[currencyCode] = name
+	}
+
+	return names, nil
+}
+
+// generateUnitPatterns generates the patterns used to combine amounts and currency names.
+//
+// Only locales with names are included. Patterns are deduplicated by parent.
+func generateUnitPatterns(names map[string]localeNames, dir string) (map[string]string, error) {
+	unitPatterns := make(map[string]string)
+	for locale := range names {
+		filename := fmt.Sprintf("%v/cldr-json/cldr-numbers-modern/main/%v/numbers.json", dir, locale)
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("generateUnitPatterns: %w", err)
+		}
+		aux := struct {
+			Main map[string]struct {
+				Numbers map[string]json.RawMessage
+			}
+		}{}
+		if err := json.Unmarshal(data, &aux); err != nil {
+			return nil, fmt.Errorf("generateUnitPatterns: %w", err)
+		}
+		numbers := aux.Main[locale].Numbers
+		var numSystem string
+		if err := json.Unmarshal(numbers["defaultNumberingSystem"], &numSystem); err != nil {
+			return nil, fmt.Errorf("generateUnitPatterns: %w", err)
+		}
+		var patterns map[string]json.RawMessage
+		if err := json.Unmarshal(numbers["currencyFormats-numberSystem-"+numSystem], &patterns); err != nil {
+			return nil, fmt.Errorf("generateUnitPatterns: %w", err)
+		}
+		// The pattern almost never differs between plural forms.
+		var unitPattern string
+		if err := json.Unmarshal(patterns["unitPattern-count-other"], &unitPattern); err != nil {
+			return nil, fmt.Errorf("generateUnitPatterns: %w", err)
+		}
+		unitPatterns[locale] = unitPattern
+	}
+
+	// Remove patterns which are identical to their parents.
+	var deleteLocales []string
+	for localeID, unitPattern := range unitPatterns {
+		locale := currency.NewLocale(localeID)
+		parentID := locale.GetParent().String()
+		if parentID != "" && unitPattern == unitPatterns[parentID] {
+			deleteLocales = append(deleteLocales, localeID)
+		}
+	}
+	for _, localeID := range deleteLocales {
+		delete(unitPatterns, localeID)
+	}
+
+	return unitPatterns, nil
+}
+
+// generatePluralRules generates cardinal plural rules from CLDR data.
+//
+// Only languages with names are included.
+// The "other" rule is omitted, since it is used when no other rule matches.
+func generatePluralRules(names map[string]localeNames, dir string) (map[string]pluralRuleSlice, error) {
+	data, err := os.ReadFile(dir + "/cldr-json/cldr-core/supplemental/plurals.json")
+	if err != nil {
+		return nil, fmt.Errorf("generatePluralRules: %w", err)
+	}
+	aux := struct {
+		Supplemental struct {
+			Plurals map[string]map[string]string `json:"plurals-type-cardinal"`
+		}
+	}{}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return nil, fmt.Errorf("generatePluralRules: %w", err)
+	}
+
+	pluralRules := make(map[string]pluralRuleSlice)
+	for locale, rules := range aux.Supplemental.Plurals {
+		if _, ok := names[locale]; !ok {
+			continue
+		}
+		var localRules pluralRuleSlice
+		for _, category := range pluralCategories {
+			rule, ok := rules["pluralRule-count-"+category]
+			if !ok || category == "other" {
+				continue
+			}
+			// Strip the samples ("@integer 1, @decimal 1.0").
+			if i := strings.Index(rule, "@"); i != -1 {
+				rule = rule[:i]
+			}
+			localRules = append(localRules, pluralRule{category, strings.TrimSpace(rule)})
+		}
+		pluralRules[locale] = localRules
+	}
+
+	return pluralRules, nil
+}
+
 // generateParentLocales generates parent locales from CLDR data.
 //
 // Ensures ignored locales are skipped.
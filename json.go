@@ -0,0 +1,157 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/cockroachdb/apd/v3"
+)
+
+// JSONFormat specifies the JSON representation of amounts.
+//
+// The zero value represents the format used by Amount itself:
+// {"number":"12.50","currency":"EUR"}. Other formats are used by
+// defining a wrapper type whose MarshalJSON and UnmarshalJSON methods
+// call Marshal and Unmarshal. For example, a format with
+// MinorUnits, Numeric, LowercaseCurrency and NumberField "amount"
+// represents the same amount as {"amount":1250,"currency":"eur"}.
+type JSONFormat struct {
+	// NumberField specifies the name of the number field.
+	// Defaults to "number".
+	NumberField string
+	// CurrencyField specifies the name of the currency code field.
+	// Defaults to "currency".
+	CurrencyField string
+	// MinorUnits represents the number as an integer in minor units
+	// (e.g. 1250 for "12.50 EUR"), as returned by Amount.BigInt().
+	// Amounts with more digits than the currency are rounded when marshaling.
+	// Defaults to false.
+	MinorUnits bool
+	// Numeric represents the number as a JSON number instead of a string.
+	// Unmarshaling accepts both. Defaults to false.
+	Numeric bool
+	// LowercaseCurrency represents the currency code in lowercase ("eur").
+	// Unmarshaling accepts both. Defaults to false.
+	LowercaseCurrency bool
+}
+
+// Marshal returns the JSON representation of an amount.
+func (f JSONFormat) Marshal(a Amount) ([]byte, error) {
+	n := a.Number()
+	if f.MinorUnits {
+		n = a.BigInt().String()
+	}
+	currencyCode := a.CurrencyCode()
+	if f.LowercaseCurrency {
+		currencyCode = strings.ToLower(currencyCode)
+	}
+
+	buf := bytes.Buffer{}
+	buf.WriteByte('{')
+	writeJSONString(&buf, f.numberField())
+	buf.WriteByte(':')
+	if f.Numeric {
+		buf.WriteString(n)
+	} else {
+		writeJSONString(&buf, n)
+	}
+	buf.WriteByte(',')
+	writeJSONString(&buf, f.currencyField())
+	buf.WriteByte(':')
+	writeJSONString(&buf, currencyCode)
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal parses the JSON representation of an amount and stores it in a.
+//
+// Field names are matched case-insensitively, as with encoding/json.
+func (f JSONFormat) Unmarshal(data []byte, a *Amount) error {
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	rawNumber := findField(fields, f.numberField())
+	var n string
+	if err := json.Unmarshal(rawNumber, &n); err != nil {
+		n = string(rawNumber)
+	}
+	var currencyCode string
+	if rawCurrencyCode := findField(fields, f.currencyField()); rawCurrencyCode != nil {
+		if err := json.Unmarshal(rawCurrencyCode, &currencyCode); err != nil {
+			return err
+		}
+	}
+	if f.LowercaseCurrency {
+		currencyCode = strings.ToUpper(currencyCode)
+	}
+
+	if f.MinorUnits {
+		coeff, ok := new(big.Int).SetString(n, 10)
+		if !ok {
+			return InvalidNumberError{n}
+		}
+		amount, err := NewAmountFromBigInt(coeff, currencyCode)
+		if err != nil {
+			return err
+		}
+		*a = amount
+		return nil
+	}
+	number := apd.Decimal{}
+	if _, _, err := number.SetString(n); err != nil {
+		return InvalidNumberError{n}
+	}
+	if currencyCode == "" || !IsValid(currencyCode) {
+		return InvalidCurrencyCodeError{currencyCode}
+	}
+	a.number = number
+	a.currencyCode = currencyCode
+
+	return nil
+}
+
+// numberField returns the name of the number field.
+func (f JSONFormat) numberField() string {
+	if f.NumberField == "" {
+		return "number"
+	}
+	return f.NumberField
+}
+
+// currencyField returns the name of the currency code field.
+func (f JSONFormat) currencyField() string {
+	if f.CurrencyField == "" {
+		return "currency"
+	}
+	return f.CurrencyField
+}
+
+// findField returns the value of the field with the given name.
+//
+// An exact match is preferred over a case-insensitive one.
+func findField(fields map[string]json.RawMessage, name string) json.RawMessage {
+	if value, ok := fields[name]; ok {
+		return value
+	}
+	for key, value := range fields {
+		if strings.EqualFold(key, name) {
+			return value
+		}
+	}
+
+	return nil
+}
+
+// writeJSONString writes s as a JSON string.
+func writeJSONString(buf *bytes.Buffer, s string) {
+	// Marshaling a string can't fail.
+	b, _ := json.Marshal(s)
+	buf.Write(b)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/plenigo/currency"
+)
+
+func TestJSONFormat_Marshal(t *testing.T) {
+	tests := []struct {
+		number       string
+		currencyCode string
+		format       currency.JSONFormat
+		want         string
+	}{
+		{"12.50", "EUR", currency.JSONFormat{}, `{"number":"12.50","currency":"EUR"}`},
+		{"12.50", "EUR", currency.JSONFormat{Numeric: true}, `{"number":12.50,"currency":"EUR"}`},
+		{"12.50", "EUR", currency.JSONFormat{MinorUnits: true}, `{"number":"1250","currency":"EUR"}`},
+		{"12.505", "EUR", currency.JSONFormat{MinorUnits: true}, `{"number":"1251","currency":"EUR"}`},
+		{"-12.5", "EUR", currency.JSONFormat{MinorUnits: true, Numeric: true}, `{"number":-1250,"currency":"EUR"}`},
+		{"1250", "JPY", currency.JSONFormat{MinorUnits: true, Numeric: true}, `{"number":1250,"currency":"JPY"}`},
+		{
+			"12.50", "EUR",
+			currency.JSONFormat{NumberField: "amount", MinorUnits: true, Numeric: true, LowercaseCurrency: true},
+			`{"amount":1250,"currency":"eur"}`,
+		},
+		{
+			"12.50", "EUR",
+			currency.JSONFormat{NumberField: "value", CurrencyField: "currency_code"},
+			`{"value":"12.50","currency_code":"EUR"}`,
+		},
+		{"12.50", "EUR", currency.JSONFormat{NumberField: `a"b`}, `{"a\"b":"12.50","currency":"EUR"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			a, _ := currency.NewAmount(tt.number, tt.currencyCode)
+			d, err := tt.format.Marshal(a)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if string(d) != tt.want {
+				t.Errorf("got %v, want %v", string(d), tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONFormat_Unmarshal(t *testing.T) {
+	tests := []struct {
+		data       string
+		format     currency.JSONFormat
+		wantNumber string
+		wantError  string
+	}{
+		{`{"number":"12.50","currency":"EUR"}`, currency.JSONFormat{}, "12.50", ""},
+		{`{"number":12.50,"currency":"EUR"}`, currency.JSONFormat{}, "12.50", ""},
+		{`{"Number":"12.50","Currency":"EUR"}`, currency.JSONFormat{}, "12.50", ""},
+		{`{"number":"12.50","currency":"eur"}`, currency.JSONFormat{}, "", `invalid currency code "eur"`},
+		{`{"number":"12.50","currency":"eur"}`, currency.JSONFormat{LowercaseCurrency: true}, "12.50", ""},
+		{`{"number":"12.50","currency":"EUR"}`, currency.JSONFormat{LowercaseCurrency: true}, "12.50", ""},
+		{`{"number":1250,"currency":"EUR"}`, currency.JSONFormat{MinorUnits: true}, "12.50", ""},
+		{`{"number":"-1250","currency":"EUR"}`, currency.JSONFormat{MinorUnits: true}, "-12.50", ""},
+		{`{"number":1250,"currency":"JPY"}`, currency.JSONFormat{MinorUnits: true}, "1250", ""},
+		{`{"number":12.50,"currency":"EUR"}`, currency.JSONFormat{MinorUnits: true}, "", `invalid number "12.50"`},
+		{`{"number":1250,"currency":"XXX"}`, currency.JSONFormat{MinorUnits: true}, "", `invalid currency code "XXX"`},
+		{
+			`{"amount":1250,"currency":"eur"}`,
+			currency.JSONFormat{NumberField: "amount", MinorUnits: true, Numeric: true, LowercaseCurrency: true},
+			"12.50", "",
+		},
+		{
+			`{"value":"12.50","currency_code":"EUR"}`,
+			currency.JSONFormat{NumberField: "value", CurrencyField: "currency_code"},
+			"12.50", "",
+		},
+		{`{"number":"12.50","currency":"EUR"}`, currency.JSONFormat{NumberField: "value"}, "", `invalid number ""`},
+		{`{"number":"12.50"}`, currency.JSONFormat{}, "", `invalid currency code ""`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.data, func(t *testing.T) {
+			a := &currency.Amount{}
+			err := tt.format.Unmarshal([]byte(tt.data), a)
+			if tt.wantError != "" {
+				if err == nil || err.Error() != tt.wantError {
+					t.Errorf("got %v, want %v", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if a.Number() != tt.wantNumber {
+				t.Errorf("got %v, want %v", a.Number(), tt.wantNumber)
+			}
+			if a.CurrencyCode() == "" {
+				t.Errorf("got an empty currency code")
+			}
+		})
+	}
+}
+
+func TestJSONFormat_UnmarshalInvalidJSON(t *testing.T) {
+	for _, data := range []string{`{"number":"12.50","currency":840}`, `"12.50 EUR"`, `{"number":`} {
+		a := &currency.Amount{}
+		err := currency.JSONFormat{}.Unmarshal([]byte(data), a)
+		if err == nil {
+			t.Errorf("%v: expected error, got nil", data)
+		}
+	}
+}
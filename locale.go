@@ -4,16 +4,41 @@
 package currency
 
 import (
+	"fmt"
+	"sort"
 	"strings"
+	"sync"
 	"unicode"
 	"unicode/utf8"
 )
 
+// InvalidLocaleError is returned when a locale is invalid or unsupported.
+type InvalidLocaleError struct {
+	Locale string
+	Reason string
+}
+
+func (e InvalidLocaleError) Error() string {
+	return fmt.Sprintf("invalid locale %q: %v", e.Locale, e.Reason)
+}
+
 // Locale represents a Unicode locale identifier.
+//
+// Supports the following Unicode extension keywords, used to override
+// the locale's defaults: "nu" (numbering system), "cu" (currency) and
+// "rg" (region). For example, "ar-EG-u-nu-latn" uses latin digits.
+// Other extensions are ignored.
 type Locale struct {
 	Language  string
 	Script    string
 	Territory string
+	// NumberingSystem is the numbering system override (e.g. "latn").
+	NumberingSystem string
+	// CurrencyCode is the currency override (e.g. "EUR").
+	CurrencyCode string
+	// RegionOverride is the region override (e.g. "GB"), used
+	// instead of Territory for region-specific defaults.
+	RegionOverride string
 }
 
 // NewLocale creates a new Locale from its string representation.
@@ -22,12 +47,20 @@ func NewLocale(id string) Locale {
 	id = strings.ToLower(strings.TrimSpace(id))
 	id = strings.ReplaceAll(id, "_", "-")
 	locale := Locale{}
-	for i, part := range strings.Split(id, "-") {
+	parts := strings.Split(id, "-")
+	for i, part := range parts {
 		if i == 0 {
 			locale.Language = part
 			continue
 		}
 		partLen := len(part)
+		if partLen == 1 {
+			// An extension or a private use section, always last.
+			if part == "u" {
+				locale.parseUnicodeExtension(parts[i+1:])
+			}
+			break
+		}
 		if partLen == 4 {
 			// Uppercase the first letter in a UTF8-safe manner.
 			r, size := utf8.DecodeRuneInString(part)
@@ -43,6 +76,41 @@ func NewLocale(id string) Locale {
 	return locale
 }
 
+// parseUnicodeExtension parses the keywords of a Unicode ("-u-") extension.
+func (l *Locale) parseUnicodeExtension(parts []string) {
+	key := ""
+	for _, part := range parts {
+		if len(part) == 1 {
+			// Start of the next extension.
+			break
+		}
+		if len(part) == 2 {
+			key = part
+			continue
+		}
+		switch key {
+		case "nu":
+			l.NumberingSystem = part
+		case "cu":
+			if len(part) == 3 {
+				l.CurrencyCode = strings.ToUpper(part)
+			}
+		case "rg":
+			// The value is a region followed by a subdivision code,
+			// or by "zzzz" for the whole region ("gbzzzz", "usca").
+			regionLen := 2
+			if part[0] >= '0' && part[0] <= '9' {
+				regionLen = 3
+			}
+			if len(part) > regionLen {
+				l.RegionOverride = strings.ToUpper(part[:regionLen])
+			}
+		}
+		// Only the first subtag of a multi-subtag value is used.
+		key = ""
+	}
+}
+
 // String returns the string representation of l.
 func (l Locale) String() string {
 	b := strings.Builder{}
@@ -55,6 +123,27 @@ func (l Locale) String() string {
 		b.WriteString("-")
 		b.WriteString(l.Territory)
 	}
+	if l.hasExtension() {
+		// Keywords are sorted by key, as required for the canonical form.
+		b.WriteString("-u")
+		if l.CurrencyCode != "" {
+			b.WriteString("-cu-")
+			b.WriteString(strings.ToLower(l.CurrencyCode))
+		}
+		if l.NumberingSystem != "" {
+			b.WriteString("-nu-")
+			b.WriteString(l.NumberingSystem)
+		}
+		if l.RegionOverride != "" {
+			b.WriteString("-rg-")
+			// The value is always 6 characters long ("gbzzzz", "419zzz").
+			region := strings.ToLower(l.RegionOverride)
+			b.WriteString(region)
+			if len(region) < 6 {
+				b.WriteString(strings.Repeat("z", 6-len(region)))
+			}
+		}
+	}
 
 	return b.String()
 }
@@ -72,7 +161,87 @@ func (l *Locale) UnmarshalText(b []byte) error {
 
 // IsEmpty returns whether l is empty.
 func (l Locale) IsEmpty() bool {
-	return l.Language == "" && l.Script == "" && l.Territory == ""
+	return l.Language == "" && l.Script == "" && l.Territory == "" && !l.hasExtension()
+}
+
+// Validate checks whether l is well-formed and supported.
+//
+// The language, script and territory must be syntactically valid, and
+// CLDR data must be available for the locale or one of its parents,
+// not counting the final fallback to English (e.g. "de-XK" is supported
+// via "de", but "xx" isn't). The numbering system and currency overrides
+// must be known.
+//
+// Note that an empty locale is considered invalid, even though
+// it is treated as "en" by the formatter and the other functions.
+func (l Locale) Validate() error {
+	id := l.String()
+	switch {
+	case l.Language == "":
+		return InvalidLocaleError{id, "missing language"}
+	case !isAlpha(l.Language) || len(l.Language) < 2 || len(l.Language) > 8 || len(l.Language) == 4:
+		return InvalidLocaleError{id, fmt.Sprintf("invalid language %q", l.Language)}
+	case l.Script != "" && (!isAlpha(l.Script) || len(l.Script) != 4):
+		return InvalidLocaleError{id, fmt.Sprintf("invalid script %q", l.Script)}
+	case l.Territory != "" && !isRegion(l.Territory):
+		return InvalidLocaleError{id, fmt.Sprintf("invalid territory %q", l.Territory)}
+	case l.RegionOverride != "" && !isRegion(l.RegionOverride):
+		return InvalidLocaleError{id, fmt.Sprintf("invalid region override %q", l.RegionOverride)}
+	}
+	if _, ok := numberingSystems[l.NumberingSystem]; l.NumberingSystem != "" && !ok {
+		return InvalidLocaleError{id, fmt.Sprintf("unknown numbering system %q", l.NumberingSystem)}
+	}
+	if l.CurrencyCode != "" && !IsValid(l.CurrencyCode) {
+		return InvalidLocaleError{id, fmt.Sprintf("unknown currency code %q", l.CurrencyCode)}
+	}
+	if !l.hasData() {
+		return InvalidLocaleError{id, "no data available"}
+	}
+
+	return nil
+}
+
+// IsSupported returns whether l is well-formed and supported.
+//
+// See Validate for details.
+func (l Locale) IsSupported() bool {
+	return l.Validate() == nil
+}
+
+// GetLocales returns all locales with CLDR data, sorted by ID.
+//
+// Includes locales with their own currency format or symbols, and
+// locales which only inherit data from their parents (e.g. "es-AR").
+func GetLocales() []Locale {
+	locales := make([]Locale, len(dataLocales()))
+	copy(locales, dataLocales())
+
+	return locales
+}
+
+// hasData returns whether CLDR data is available for l or one of its parents.
+//
+// The final fallback to "en" is only considered for English locales.
+func (l Locale) hasData() bool {
+	ids := dataLocaleIDs()
+	language := l.Language
+	for locale := l.base(); !locale.IsEmpty() && locale.Language == language; locale = locale.GetParent() {
+		if ids[locale.String()] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// region returns the region used for region-specific defaults.
+//
+// Returns the region override if set, and the territory otherwise.
+func (l Locale) region() string {
+	if l.RegionOverride != "" {
+		return l.RegionOverride
+	}
+	return l.Territory
 }
 
 // GetParent returns the parent locale for l.
@@ -86,8 +255,9 @@ func (l Locale) IsEmpty() bool {
 //
 // Note that according to CLDR rules, certain locales have special parents.
 // For example, the parent for "es-AR" is "es-419", and for "sr-Latn" it is "en".
+// Unicode extensions are not inherited by the parent.
 func (l Locale) GetParent() Locale {
-	localeID := l.String()
+	localeID := l.base().String()
 	if localeID == "" || localeID == "en" {
 		return Locale{}
 	}
@@ -103,3 +273,97 @@ func (l Locale) GetParent() Locale {
 		return Locale{Language: "en"}
 	}
 }
+
+// base returns l without its Unicode extensions.
+//
+// CLDR data is keyed by base locale IDs.
+func (l Locale) base() Locale {
+	return Locale{Language: l.Language, Script: l.Script, Territory: l.Territory}
+}
+
+// hasExtension returns whether l has any Unicode extension keywords.
+func (l Locale) hasExtension() bool {
+	return l.NumberingSystem != "" || l.CurrencyCode != "" || l.RegionOverride != ""
+}
+
+var dataLocaleList struct {
+	sync.Once
+	locales []Locale
+	ids     map[string]bool
+}
+
+// dataLocales returns all locales with CLDR data, sorted by ID.
+func dataLocales() []Locale {
+	loadDataLocales()
+	return dataLocaleList.locales
+}
+
+// dataLocaleIDs returns the IDs of all locales with CLDR data.
+func dataLocaleIDs() map[string]bool {
+	loadDataLocales()
+	return dataLocaleList.ids
+}
+
+// loadDataLocales builds the list of locales with CLDR data, once.
+func loadDataLocales() {
+	dataLocaleList.Do(func() {
+		ids := make(map[string]bool, len(currencyFormats)+len(parentLocales))
+		add := func(id string) {
+			// Variants are unsupported, "el-polyton" is treated as "el".
+			if id = NewLocale(id).String(); id != "" {
+				ids[id] = true
+			}
+		}
+		for id := range currencyFormats {
+			add(id)
+		}
+		for id, parentID := range parentLocales {
+			add(id)
+			add(parentID)
+		}
+		for _, symbols := range currencySymbols {
+			for _, si := range symbols {
+				for _, id := range si.locales {
+					add(id)
+				}
+			}
+		}
+		sortedIDs := make([]string, 0, len(ids))
+		for id := range ids {
+			sortedIDs = append(sortedIDs, id)
+		}
+		sort.Strings(sortedIDs)
+		locales := make([]Locale, 0, len(sortedIDs))
+		for _, id := range sortedIDs {
+			locales = append(locales, NewLocale(id))
+		}
+		dataLocaleList.locales = locales
+		dataLocaleList.ids = ids
+	})
+}
+
+// isAlpha returns whether s consists of ASCII letters.
+func isAlpha(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// isRegion returns whether s is a valid region code ("US", "419").
+func isRegion(s string) bool {
+	if len(s) == 2 {
+		return isAlpha(s)
+	}
+	if len(s) == 3 {
+		for _, r := range s {
+			if r < '0' || r > '9' {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -27,6 +27,14 @@ func TestNewLocale(t *testing.T) {
 		{"SR_rs_LATN", currency.Locale{Language: "sr", Script: "Latn", Territory: "RS"}},
 		// ID with a variant. Variants are unsupported and ignored.
 		{"ca-ES-VALENCIA", currency.Locale{Language: "ca", Territory: "ES"}},
+		// IDs with Unicode extensions.
+		{"ar-EG-u-nu-latn", currency.Locale{Language: "ar", Territory: "EG", NumberingSystem: "latn"}},
+		{"en-US-u-cu-eur", currency.Locale{Language: "en", Territory: "US", CurrencyCode: "EUR"}},
+		{"en-u-rg-gbzzzz", currency.Locale{Language: "en", RegionOverride: "GB"}},
+		{"EN_us_U_RG_USCA_NU_ARAB", currency.Locale{Language: "en", Territory: "US", NumberingSystem: "arab", RegionOverride: "US"}},
+		// Unsupported keywords and other extensions are ignored.
+		{"de-DE-u-ca-buddhist-nu-latn-x-private", currency.Locale{Language: "de", Territory: "DE", NumberingSystem: "latn"}},
+		{"de-t-en-u-nu-latn", currency.Locale{Language: "de"}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id, func(t *testing.T) {
@@ -48,6 +56,8 @@ func TestLocale_String(t *testing.T) {
 		{currency.Locale{Language: "de", Territory: "CH"}, "de-CH"},
 		{currency.Locale{Language: "sr", Script: "Cyrl"}, "sr-Cyrl"},
 		{currency.Locale{Language: "sr", Script: "Latn", Territory: "RS"}, "sr-Latn-RS"},
+		{currency.Locale{Language: "ar", Territory: "EG", NumberingSystem: "latn"}, "ar-EG-u-nu-latn"},
+		{currency.Locale{Language: "en", CurrencyCode: "EUR", NumberingSystem: "arab", RegionOverride: "GB"}, "en-u-cu-eur-nu-arab-rg-gbzzzz"},
 	}
 	for _, tt := range tests {
 		t.Run("", func(t *testing.T) {
@@ -141,6 +151,9 @@ func TestLocale_GetParent(t *testing.T) {
 		// Locales with special parents.
 		{"es-AR", currency.Locale{Language: "es", Territory: "419"}},
 		{"sr-Latn", currency.Locale{Language: "en"}},
+		// Extensions are not inherited.
+		{"sr-Cyrl-RS-u-nu-latn", currency.Locale{Language: "sr", Script: "Cyrl"}},
+		{"es-AR-u-cu-usd", currency.Locale{Language: "es", Territory: "419"}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id, func(t *testing.T) {
@@ -152,3 +165,83 @@ func TestLocale_GetParent(t *testing.T) {
 		})
 	}
 }
+
+func TestLocale_Validate(t *testing.T) {
+	tests := []struct {
+		locale  currency.Locale
+		wantErr string
+	}{
+		{currency.NewLocale("en"), ""},
+		{currency.NewLocale("de-CH"), ""},
+		{currency.NewLocale("de-XK"), ""},
+		{currency.NewLocale("es-AR"), ""},
+		{currency.NewLocale("sr-Latn-RS"), ""},
+		{currency.NewLocale("es-419"), ""},
+		{currency.NewLocale("ar-EG-u-nu-latn-cu-usd"), ""},
+
+		{currency.Locale{}, `invalid locale "": missing language`},
+		{currency.NewLocale("xx-garbage"), `invalid locale "xx": no data available`},
+		{currency.NewLocale("xx-US"), `invalid locale "xx-US": no data available`},
+		{currency.Locale{Language: "d3"}, `invalid locale "d3": invalid language "d3"`},
+		{currency.Locale{Language: "de", Script: "L4tn"}, `invalid locale "de-L4tn": invalid script "L4tn"`},
+		{currency.Locale{Language: "de", Territory: "C"}, `invalid locale "de-C": invalid territory "C"`},
+		{currency.NewLocale("de-u-nu-xxxx"), `invalid locale "de-u-nu-xxxx": unknown numbering system "xxxx"`},
+		{currency.NewLocale("de-u-cu-xxx"), `invalid locale "de-u-cu-xxx": unknown currency code "XXX"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.locale.String(), func(t *testing.T) {
+			err := tt.locale.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				if !tt.locale.IsSupported() {
+					t.Errorf("got false, want true")
+				}
+				return
+			}
+			if _, ok := err.(currency.InvalidLocaleError); !ok {
+				t.Fatalf("got %T, want currency.InvalidLocaleError", err)
+			}
+			if err.Error() != tt.wantErr {
+				t.Errorf("got %v, want %v", err, tt.wantErr)
+			}
+			if tt.locale.IsSupported() {
+				t.Errorf("got true, want false")
+			}
+		})
+	}
+}
+
+func TestGetLocales(t *testing.T) {
+	locales := currency.GetLocales()
+	if len(locales) < 100 {
+		t.Errorf("got %v locales, want at least 100", len(locales))
+	}
+	for i, locale := range locales {
+		if i > 0 && locales[i-1].String() >= locale.String() {
+			t.Errorf("locales not sorted: %v before %v", locales[i-1], locale)
+		}
+		if err := locale.Validate(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	for _, id := range []string{"de", "de-CH", "en", "es-AR", "sr-Latn"} {
+		found := false
+		for _, locale := range locales {
+			if locale.String() == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("locale %v not found", id)
+		}
+	}
+
+	// Modifying the returned slice must not affect other callers.
+	locales[0] = currency.Locale{Language: "xx"}
+	if currency.GetLocales()[0].String() == "xx" {
+		t.Errorf("GetLocales returned a shared slice")
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Currency names are stored separately from the rest of the CLDR data,
+// in data_names.go, because they take up significantly more space.
+// They are only included when building with the "currency_names" tag,
+// otherwise currency codes are shown instead.
+
+type nameInfo struct {
+	displayName string
+	// plurals maps plural categories ("one", "few", "other", etc) to names.
+	plurals map[string]string
+}
+
+type pluralRule struct {
+	category  string
+	condition string
+}
+
+// getName returns the name info for a currency code and locale.
+func getName(currencyCode string, locale Locale) (nameInfo, bool) {
+	locale = locale.base()
+	if locale.IsEmpty() {
+		locale = Locale{Language: "en"}
+	}
+	for {
+		if names, ok := currencyNames[locale.String()]; ok {
+			if name, ok := names[currencyCode]; ok {
+				return name, true
+			}
+		}
+		locale = locale.GetParent()
+		if locale.IsEmpty() {
+			break
+		}
+	}
+
+	return nameInfo{}, false
+}
+
+// getPluralName returns the name of a currency code for the given formatted number.
+//
+// The number is expected to use latin digits and "." as the decimal separator.
+func getPluralName(currencyCode string, locale Locale, number string) string {
+	name, ok := getName(currencyCode, locale)
+	if !ok {
+		return currencyCode
+	}
+	category := pluralCategory(locale, number)
+	if pluralName, ok := name.plurals[category]; ok {
+		return pluralName
+	}
+	if pluralName, ok := name.plurals["other"]; ok {
+		return pluralName
+	}
+
+	return name.displayName
+}
+
+// getUnitPattern returns the pattern used to combine an amount with a currency name.
+//
+// The pattern contains the "{0}" (amount) and "{1}" (name) placeholders.
+func getUnitPattern(locale Locale) string {
+	locale = locale.base()
+	if locale.IsEmpty() {
+		locale = Locale{Language: "en"}
+	}
+	for {
+		if unitPattern, ok := currencyUnitPatterns[locale.String()]; ok {
+			return unitPattern
+		}
+		locale = locale.GetParent()
+		if locale.IsEmpty() {
+			break
+		}
+	}
+
+	return "{0} {1}"
+}
+
+// pluralCategory returns the CLDR plural category of a number in the given locale.
+//
+// The number is expected to use latin digits and "." as the decimal separator.
+// See https://unicode.org/reports/tr35/tr35-numbers.html#Language_Plural_Rules
+func pluralCategory(locale Locale, number string) string {
+	var rules []pluralRule
+	locale = locale.base()
+	for {
+		var ok bool
+		if rules, ok = pluralRules[locale.String()]; ok {
+			break
+		}
+		locale = locale.GetParent()
+		if locale.IsEmpty() {
+			break
+		}
+	}
+	operands := newPluralOperands(number)
+	for _, rule := range rules {
+		if operands.matches(rule.condition) {
+			return rule.category
+		}
+	}
+
+	return "other"
+}
+
+// pluralOperands are the operands used by plural rule conditions.
+type pluralOperands struct {
+	// i is the integer digits of n.
+	i int64
+	// v is the number of visible fraction digits, with trailing zeroes.
+	v int64
+	// w is the number of visible fraction digits, without trailing zeroes.
+	w int64
+	// f is the visible fraction digits, with trailing zeroes.
+	f int64
+	// t is the visible fraction digits, without trailing zeroes.
+	t int64
+}
+
+// newPluralOperands creates plural operands for the given number.
+func newPluralOperands(number string) pluralOperands {
+	number = strings.TrimLeft(number, "+-")
+	integerDigits, fractionDigits := number, ""
+	if i := strings.IndexByte(number, '.'); i != -1 {
+		integerDigits, fractionDigits = number[:i], number[i+1:]
+	}
+	trimmedDigits := strings.TrimRight(fractionDigits, "0")
+	operands := pluralOperands{
+		v: int64(len(fractionDigits)),
+		w: int64(len(trimmedDigits)),
+	}
+	// Overflowing numbers are clamped, which is good enough for matching
+	// rules that only look at the last few digits.
+	operands.i, _ = strconv.ParseInt(integerDigits, 10, 64)
+	operands.f, _ = strconv.ParseInt(fractionDigits, 10, 64)
+	operands.t, _ = strconv.ParseInt(trimmedDigits, 10, 64)
+
+	return operands
+}
+
+// matches returns whether the operands match the given condition.
+//
+// Conditions are in the CLDR syntax, e.g. "i = 1 and v = 0 or n = 2..4,7".
+func (o pluralOperands) matches(condition string) bool {
+	for _, andCondition := range strings.Split(condition, " or ") {
+		matched := true
+		for _, relation := range strings.Split(andCondition, " and ") {
+			if !o.matchesRelation(strings.TrimSpace(relation)) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesRelation returns whether the operands match a single relation ("i % 10 = 2..4").
+func (o pluralOperands) matchesRelation(relation string) bool {
+	negate := false
+	parts := strings.SplitN(relation, "!=", 2)
+	if len(parts) == 2 {
+		negate = true
+	} else {
+		parts = strings.SplitN(relation, "=", 2)
+		if len(parts) != 2 {
+			return false
+		}
+	}
+	expr := strings.Fields(parts[0])
+	if len(expr) == 0 {
+		return false
+	}
+	var value int64
+	integer := true
+	switch expr[0] {
+	case "n":
+		value = o.i
+		// A number with a fraction never equals an integer.
+		integer = o.t == 0
+	case "i":
+		value = o.i
+	case "v":
+		value = o.v
+	case "w":
+		value = o.w
+	case "f":
+		value = o.f
+	case "t":
+		value = o.t
+	default:
+		// The compact decimal exponent (c, e) is always 0.
+		value = 0
+	}
+	if len(expr) == 3 && expr[1] == "%" {
+		modulus, _ := strconv.ParseInt(expr[2], 10, 64)
+		if modulus != 0 {
+			value = value % modulus
+		}
+	}
+
+	matched := false
+	if integer {
+		for _, r := range strings.Split(strings.TrimSpace(parts[1]), ",") {
+			low, high := r, r
+			if i := strings.Index(r, ".."); i != -1 {
+				low, high = r[:i], r[i+2:]
+			}
+			lowValue, _ := strconv.ParseInt(strings.TrimSpace(low), 10, 64)
+			highValue, _ := strconv.ParseInt(strings.TrimSpace(high), 10, 64)
+			if value >= lowValue && value <= highValue {
+				matched = true
+				break
+			}
+		}
+	}
+
+	return matched != negate
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+//go:build !currency_names
+// +build !currency_names
+
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/plenigo/currency"
+)
+
+func TestGetDisplayName_NoNames(t *testing.T) {
+	gotDisplayName, gotOk := currency.GetDisplayName("USD", currency.NewLocale("en"))
+	if gotDisplayName != "USD" {
+		t.Errorf("got %v, want USD", gotDisplayName)
+	}
+	if gotOk {
+		t.Errorf("got %v, want false", gotOk)
+	}
+}
+
+func TestFormatter_DisplayName_NoNames(t *testing.T) {
+	amount, _ := currency.NewAmount("3", "USD")
+	formatter := currency.NewFormatter(currency.NewLocale("en"))
+	formatter.CurrencyDisplay = currency.DisplayName
+	got := formatter.Format(amount)
+	if got != "3.00 USD" {
+		t.Errorf("got %v, want 3.00 USD", got)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+//go:build currency_names
+// +build currency_names
+
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/plenigo/currency"
+)
+
+func TestGetDisplayName(t *testing.T) {
+	tests := []struct {
+		currencyCode    string
+		locale          currency.Locale
+		wantDisplayName string
+		wantOk          bool
+	}{
+		{"XXX", currency.NewLocale("en"), "XXX", false},
+		{"usd", currency.NewLocale("en"), "usd", false},
+		{"RSD", currency.NewLocale("en"), "Serbian Dinar", true},
+		{"USD", currency.NewLocale("en"), "US Dollar", true},
+		{"USD", currency.NewLocale("en-AU"), "US Dollar", true},
+		{"USD", currency.NewLocale("de-CH"), "US-Dollar", true},
+		{"USD", currency.NewLocale("fr"), "dollar des États-Unis", true},
+		// An empty locale should use "en" data.
+		{"USD", currency.NewLocale(""), "US Dollar", true},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			gotDisplayName, gotOk := currency.GetDisplayName(tt.currencyCode, tt.locale)
+			if gotDisplayName != tt.wantDisplayName {
+				t.Errorf("got %v, want %v", gotDisplayName, tt.wantDisplayName)
+			}
+			if gotOk != tt.wantOk {
+				t.Errorf("got %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestFormatter_DisplayName(t *testing.T) {
+	tests := []struct {
+		number       string
+		currencyCode string
+		localeID     string
+		minDigits    uint8
+		want         string
+	}{
+		{"1", "USD", "en", 0, "1 US dollar"},
+		{"1", "USD", "en", currency.DefaultDigits, "1.00 US dollars"},
+		{"1234.5", "USD", "en", currency.DefaultDigits, "1,234.50 US dollars"},
+		{"-1", "USD", "en", 0, "-1 US dollar"},
+		{"1", "USD", "en-GB", 0, "1 US dollar"},
+		{"3", "EUR", "de-AT", 0, "3 Euro"},
+		{"1.5", "EUR", "fr", 0, "1,5 euro"},
+		{"2", "EUR", "fr", 0, "2 euros"},
+		{"1", "PLN", "pl", 0, "1 złoty polski"},
+		{"3", "PLN", "pl", 0, "3 złote polskie"},
+		{"5", "PLN", "pl", 0, "5 złotych polskich"},
+		{"12", "PLN", "pl", 0, "12 złotych polskich"},
+		{"22", "PLN", "pl", 0, "22 złote polskie"},
+		{"1.5", "PLN", "pl", 0, "1,5 złotego polskiego"},
+		{"3", "USD", "ja", 0, "3米ドル"},
+		{"10", "EUR", "nl", currency.DefaultDigits, "10,00 euro"},
+		{"3", "USD", "pt-BR", 0, "3 Dólares americanos"},
+		{"3", "RSD", "sv", 0, "3 serbiska dinarer"},
+		{"3", "RSD", "ru", 0, "3 сербских динара"},
+		{"10", "RSD", "ru", 0, "10 сербских динаров"},
+		// Locales without names fall back to "en", like symbols do.
+		{"3", "USD", "eo", 0, "3 US dollars"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			amount, _ := currency.NewAmount(tt.number, tt.currencyCode)
+			locale := currency.NewLocale(tt.localeID)
+			formatter := currency.NewFormatter(locale)
+			formatter.MinDigits = tt.minDigits
+			formatter.CurrencyDisplay = currency.DisplayName
+			got := formatter.Format(amount)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatter_FormatRangeDisplayName(t *testing.T) {
+	tests := []struct {
+		min      string
+		max      string
+		localeID string
+		want     string
+	}{
+		{"1", "2", "en", "1–2 US dollars"},
+		{"0", "1", "en", "0–1 US dollar"},
+		{"1", "1", "en", "~1 US dollar"},
+		{"2", "3", "pl", "2–3 dolary amerykańskie"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			min, _ := currency.NewAmount(tt.min, "USD")
+			max, _ := currency.NewAmount(tt.max, "USD")
+			locale := currency.NewLocale(tt.localeID)
+			formatter := currency.NewFormatter(locale)
+			formatter.MinDigits = 0
+			formatter.CurrencyDisplay = currency.DisplayName
+			got, _ := formatter.FormatRange(min, max)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Confidence indicates how well a negotiated locale matches the requested one.
+type Confidence uint8
+
+const (
+	// ConfidenceNone indicates that no requested locale was matched,
+	// and the default locale was returned instead.
+	ConfidenceNone Confidence = iota
+	// ConfidenceLow indicates a match on the language only (e.g. "de-AT" => "de-CH").
+	ConfidenceLow
+	// ConfidenceHigh indicates a match on a parent locale (e.g. "de-AT" => "de").
+	ConfidenceHigh
+	// ConfidenceExact indicates an exact match.
+	ConfidenceExact
+)
+
+// String returns the string representation of c.
+func (c Confidence) String() string {
+	switch c {
+	case ConfidenceLow:
+		return "low"
+	case ConfidenceHigh:
+		return "high"
+	case ConfidenceExact:
+		return "exact"
+	default:
+		return "none"
+	}
+}
+
+// NegotiateLocale returns the supported locale best matching an Accept-Language header.
+//
+// The header is in the format defined by RFC 7231, e.g. "fr-CH, fr;q=0.9, en;q=0.8".
+// Requested locales are tried in order of their quality value, and the first
+// one matching a supported locale wins. A requested locale matches a supported
+// locale exactly, via one of its parents (see Locale.GetParent), or by sharing
+// its language, in that order of preference.
+//
+// If supported is empty, all locales with CLDR data are supported.
+// If nothing matches, the first supported locale ("en" if supported
+// is empty) is returned with ConfidenceNone.
+func NegotiateLocale(acceptLanguage string, supported []Locale) (Locale, Confidence) {
+	defaultLocale := Locale{Language: "en"}
+	if len(supported) == 0 {
+		supported = dataLocales()
+	} else {
+		defaultLocale = supported[0]
+	}
+	for _, requested := range parseAcceptLanguage(acceptLanguage) {
+		if locale, confidence := matchLocale(requested, supported); confidence != ConfidenceNone {
+			return locale, confidence
+		}
+	}
+
+	return defaultLocale, ConfidenceNone
+}
+
+// matchLocale returns the supported locale best matching the requested one.
+//
+// Unicode extensions of the requested locale (e.g. "-u-nu-latn") are
+// carried over to a matched supported locale which has none of its own.
+func matchLocale(requested Locale, supported []Locale) (Locale, Confidence) {
+	match := func(s Locale, confidence Confidence) (Locale, Confidence) {
+		if !s.hasExtension() {
+			s.NumberingSystem = requested.NumberingSystem
+			s.CurrencyCode = requested.CurrencyCode
+			s.RegionOverride = requested.RegionOverride
+		}
+		return s, confidence
+	}
+	language := requested.Language
+	for locale, confidence := requested.base(), ConfidenceExact; !locale.IsEmpty() && locale.Language == language; {
+		for _, s := range supported {
+			if s.base() == locale {
+				return match(s, confidence)
+			}
+		}
+		locale, confidence = locale.GetParent(), ConfidenceHigh
+	}
+	for _, s := range supported {
+		if s.Language == language {
+			return match(s, ConfidenceLow)
+		}
+	}
+
+	return Locale{}, ConfidenceNone
+}
+
+// parseAcceptLanguage parses an Accept-Language header.
+//
+// Returns the requested locales, ordered by their quality value.
+// Wildcards, invalid entries, and entries with a zero quality value are skipped.
+func parseAcceptLanguage(acceptLanguage string) []Locale {
+	type entry struct {
+		locale  Locale
+		quality float64
+	}
+	var entries []entry
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		params := strings.Split(part, ";")
+		tag := strings.TrimSpace(params[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+		quality := 1.0
+		for _, param := range params[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				var err error
+				quality, err = strconv.ParseFloat(param[2:], 64)
+				if err != nil || quality < 0 || quality > 1 {
+					quality = 0
+				}
+			}
+		}
+		if quality > 0 {
+			entries = append(entries, entry{NewLocale(tag), quality})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].quality > entries[j].quality
+	})
+	locales := make([]Locale, 0, len(entries))
+	for _, e := range entries {
+		locales = append(locales, e.locale)
+	}
+
+	return locales
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/plenigo/currency"
+)
+
+func TestNegotiateLocale(t *testing.T) {
+	tests := []struct {
+		acceptLanguage string
+		supported      []string
+		want           string
+		wantConfidence currency.Confidence
+	}{
+		// All locales with data.
+		{"de-CH", nil, "de-CH", currency.ConfidenceExact},
+		{"de-DE", nil, "de", currency.ConfidenceHigh},
+		{"es-AR", nil, "es-AR", currency.ConfidenceExact},
+		{"xx-YY, fr;q=0.5", nil, "fr", currency.ConfidenceExact},
+		{"xx", nil, "en", currency.ConfidenceNone},
+		{"", nil, "en", currency.ConfidenceNone},
+
+		// App-supported locales.
+		{"fr-CH, fr;q=0.9, en;q=0.8", []string{"en", "fr"}, "fr", currency.ConfidenceHigh},
+		{"en;q=0.8, fr-CH", []string{"en", "fr-CH"}, "fr-CH", currency.ConfidenceExact},
+		{"es-AR", []string{"en", "es-419"}, "es-419", currency.ConfidenceHigh},
+		{"de-AT", []string{"en", "de-CH"}, "de-CH", currency.ConfidenceLow},
+		{"de-AT;q=0, en-GB", []string{"de", "en"}, "en", currency.ConfidenceHigh},
+		{"sr-Latn", []string{"de", "en"}, "de", currency.ConfidenceNone},
+		{"*", []string{"de", "en"}, "de", currency.ConfidenceNone},
+		{"fr;q=invalid, DE_at", []string{"fr", "de"}, "de", currency.ConfidenceHigh},
+		// Unicode extensions are carried over.
+		{"ar-EG-u-nu-latn", []string{"en", "ar"}, "ar-u-nu-latn", currency.ConfidenceHigh},
+		{"ar-EG-u-nu-latn", []string{"en", "ar-EG-u-nu-arab"}, "ar-EG-u-nu-arab", currency.ConfidenceExact},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.acceptLanguage, func(t *testing.T) {
+			var supported []currency.Locale
+			for _, id := range tt.supported {
+				supported = append(supported, currency.NewLocale(id))
+			}
+			got, confidence := currency.NegotiateLocale(tt.acceptLanguage, supported)
+			if got.String() != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if confidence != tt.wantConfidence {
+				t.Errorf("got %v, want %v", confidence, tt.wantConfidence)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency
+
+// NumberingSystem represents a CLDR numbering system.
+//
+// Determines the digits used when formatting amounts, along with the
+// locale's separators, patterns and signs for that numbering system.
+type NumberingSystem uint8
+
+const (
+	// NumLatn uses latin digits (0123456789).
+	NumLatn NumberingSystem = iota
+	// NumArab uses Arabic-Indic digits (٠١٢٣٤٥٦٧٨٩).
+	NumArab
+	// NumArabExt uses extended Arabic-Indic (Persian) digits (۰۱۲۳۴۵۶۷۸۹).
+	NumArabExt
+	// NumBeng uses Bengali digits (০১২৩৪৫৬৭৮৯).
+	NumBeng
+	// NumDeva uses Devanagari digits (०१२३४५६७८९).
+	NumDeva
+	// NumMymr uses Myanmar (Burmese) digits (၀၁၂၃၄၅၆၇၈၉).
+	NumMymr
+	// NumThai uses Thai digits (๐๑๒๓๔๕๖๗๘๙).
+	NumThai
+	// NumTibt uses Tibetan digits (༠༡༢༣༤༥༦༧༨༩).
+	NumTibt
+	// NumFullwide uses full-width digits (０１２３４５６７８９).
+	NumFullwide
+	// NumHanidec uses Chinese decimal digits (〇一二三四五六七八九).
+	NumHanidec
+)
+
+// numberingSystemIDs maps numbering systems to their CLDR IDs.
+var numberingSystemIDs = []string{
+	NumLatn:     "latn",
+	NumArab:     "arab",
+	NumArabExt:  "arabext",
+	NumBeng:     "beng",
+	NumDeva:     "deva",
+	NumMymr:     "mymr",
+	NumThai:     "thai",
+	NumTibt:     "tibt",
+	NumFullwide: "fullwide",
+	NumHanidec:  "hanidec",
+}
+
+// numberingSystems maps CLDR IDs to numbering systems.
+var numberingSystems = map[string]NumberingSystem{
+	"latn":     NumLatn,
+	"arab":     NumArab,
+	"arabext":  NumArabExt,
+	"beng":     NumBeng,
+	"deva":     NumDeva,
+	"mymr":     NumMymr,
+	"thai":     NumThai,
+	"tibt":     NumTibt,
+	"fullwide": NumFullwide,
+	"hanidec":  NumHanidec,
+}
+
+// localDigits holds the digits of each non-latin numbering system.
+var localDigits = map[NumberingSystem]string{
+	NumArab:     "٠١٢٣٤٥٦٧٨٩",
+	NumArabExt:  "۰۱۲۳۴۵۶۷۸۹",
+	NumBeng:     "০১২৩৪৫৬৭৮৯",
+	NumDeva:     "०१२३४५६७८९",
+	NumMymr:     "၀၁၂၃၄၅၆၇၈၉",
+	NumThai:     "๐๑๒๓๔๕๖๗๘๙",
+	NumTibt:     "༠༡༢༣༤༥༦༧༨༩",
+	NumFullwide: "０１２３４５６７８９",
+	NumHanidec:  "〇一二三四五六七八九",
+}
+
+// String returns the CLDR ID of n (e.g. "latn").
+func (n NumberingSystem) String() string {
+	if !n.isValid() {
+		return ""
+	}
+	return numberingSystemIDs[n]
+}
+
+// isValid returns whether n is a known numbering system.
+func (n NumberingSystem) isValid() bool {
+	return int(n) < len(numberingSystemIDs)
+}
+
+// isArabic returns whether n is one of the Arabic numbering systems.
+//
+// Unlike the other numbering systems, they don't use the latin separators.
+func (n NumberingSystem) isArabic() bool {
+	return n == NumArab || n == NumArabExt
+}
+
+// getNumberingSystemFormat returns the format for a locale and numbering system.
+//
+// Locales only have format data for their default numbering system and
+// a few alternatives. If the numbering system has no data, the locale's
+// default format is used with the numbering system's digits, and the
+// separators are swapped when switching to or from the Arabic numbering
+// systems (as they don't use the latin "." and ",").
+func getNumberingSystemFormat(locale Locale, numSystem NumberingSystem) currencyFormat {
+	locale = locale.base()
+	if locale.IsEmpty() {
+		locale = Locale{Language: "en"}
+	}
+	language := locale.Language
+	for l := locale; !l.IsEmpty() && l.Language == language; l = l.GetParent() {
+		localeID := l.String()
+		if cf, ok := numberingSystemFormats[localeID][numSystem]; ok {
+			return cf
+		}
+		if cf, ok := currencyFormats[localeID]; ok && cf.numberingSystem == numSystem {
+			return cf
+		}
+	}
+
+	format, _ := getFormat(locale)
+	if numSystem.isArabic() && !format.numberingSystem.isArabic() {
+		format.decimalSeparator = "٫"
+		if format.groupingSeparator != "" {
+			format.groupingSeparator = "٬"
+		}
+	} else if !numSystem.isArabic() && format.numberingSystem.isArabic() {
+		format.decimalSeparator = "."
+		if format.groupingSeparator != "" {
+			format.groupingSeparator = ","
+		}
+	}
+	format.numberingSystem = numSystem
+
+	return format
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/plenigo/currency"
+)
+
+func TestNumberingSystem_String(t *testing.T) {
+	tests := []struct {
+		numberingSystem currency.NumberingSystem
+		want            string
+	}{
+		{currency.NumLatn, "latn"},
+		{currency.NumArabExt, "arabext"},
+		{currency.NumHanidec, "hanidec"},
+		{currency.NumberingSystem(99), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := tt.numberingSystem.String()
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
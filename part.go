@@ -0,0 +1,91 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PartType represents the type of a formatted part.
+type PartType uint8
+
+const (
+	// PartLiteral is a literal string from the pattern (e.g. a space).
+	PartLiteral PartType = iota
+	// PartCurrency is the currency symbol, code, or name.
+	PartCurrency
+	// PartInteger is a group of integer digits.
+	PartInteger
+	// PartGroup is the grouping separator.
+	PartGroup
+	// PartDecimal is the decimal separator.
+	PartDecimal
+	// PartFraction is the fraction digits.
+	PartFraction
+	// PartMinusSign is the minus sign.
+	PartMinusSign
+	// PartPlusSign is the plus sign.
+	PartPlusSign
+)
+
+var partTypeNames = []string{
+	"literal", "currency", "integer", "group", "decimal", "fraction", "minusSign", "plusSign",
+}
+
+// String returns the name of t, matching the part types of
+// JavaScript's Intl.NumberFormat.formatToParts() (e.g. "minusSign").
+func (t PartType) String() string {
+	if int(t) < len(partTypeNames) {
+		return partTypeNames[t]
+	}
+	return fmt.Sprintf("PartType(%d)", int(t))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (t PartType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (t *PartType) UnmarshalText(b []byte) error {
+	for i, name := range partTypeNames {
+		if name == string(b) {
+			*t = PartType(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid part type %q", string(b))
+}
+
+// Part is a part of a formatted amount.
+type Part struct {
+	Type  PartType `json:"type"`
+	Value string   `json:"value"`
+}
+
+// appendPart appends a part to the given parts.
+//
+// Empty values are skipped, and consecutive literals are merged.
+func appendPart(parts []Part, partType PartType, value string) []Part {
+	if value == "" {
+		return parts
+	}
+	if partType == PartLiteral && len(parts) > 0 && parts[len(parts)-1].Type == PartLiteral {
+		parts[len(parts)-1].Value += value
+		return parts
+	}
+
+	return append(parts, Part{partType, value})
+}
+
+// joinParts concatenates the values of the given parts.
+func joinParts(parts []Part) string {
+	b := strings.Builder{}
+	for _, part := range parts {
+		b.WriteString(part.Value)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// InvalidPatternError is returned when a custom pattern is invalid or unsupported.
+type InvalidPatternError struct {
+	Pattern string
+	Reason  string
+}
+
+func (e InvalidPatternError) Error() string {
+	return fmt.Sprintf("invalid pattern %q: %v", e.Pattern, e.Reason)
+}
+
+// customPattern is a parsed custom pattern.
+type customPattern struct {
+	// pattern is the pattern in the internal format, with the number
+	// replaced by "0.00" and literals unquoted (e.g. "¤¤ 0.00;¤¤ 0.00-").
+	pattern               string
+	primaryGroupingSize   uint8
+	secondaryGroupingSize uint8
+	// literals are the words of the literal text, ordered from longest to
+	// shortest, skipped when parsing.
+	literals []string
+	// parens indicates that the pattern uses parentheses for negative amounts.
+	parens bool
+}
+
+// addLiteral adds the words of the given literal text.
+func (p *customPattern) addLiteral(literal string) {
+	if strings.ContainsAny(literal, "()") {
+		p.parens = true
+		literal = strings.NewReplacer("(", " ", ")", " ").Replace(literal)
+	}
+	for _, word := range strings.Fields(literal) {
+		if !contains(p.literals, word) {
+			p.literals = append(p.literals, word)
+		}
+	}
+	sort.Slice(p.literals, func(i, j int) bool {
+		return len(p.literals[i]) > len(p.literals[j])
+	})
+}
+
+// apply returns a copy of the format using the custom pattern.
+func (p *customPattern) apply(cf currencyFormat) currencyFormat {
+	cf.standardPattern = p.pattern
+	cf.accountingPattern = ""
+	cf.minGroupingDigits = 1
+	cf.primaryGroupingSize = p.primaryGroupingSize
+	cf.secondaryGroupingSize = p.secondaryGroupingSize
+
+	return cf
+}
+
+// parsePattern parses a CLDR-style currency pattern.
+//
+// See Formatter.SetPattern for the supported syntax.
+func parsePattern(pattern string) (*customPattern, error) {
+	p := &customPattern{}
+	var subpatterns []string
+	b := strings.Builder{}
+	hasNumber, hasCurrency := false, false
+	literal := strings.Builder{}
+	for i := 0; i < len(pattern); {
+		r, size := utf8.DecodeRuneInString(pattern[i:])
+		if r != '\'' && (r == '¤' || r == ';' || strings.ContainsRune("#0,.+-", r)) {
+			p.addLiteral(literal.String())
+			literal.Reset()
+		}
+		switch {
+		case r == '\'':
+			end := i + 1
+			quoted := strings.Builder{}
+			for {
+				j := strings.IndexByte(pattern[end:], '\'')
+				if j == -1 {
+					return nil, InvalidPatternError{pattern, "unterminated quote"}
+				}
+				quoted.WriteString(pattern[end : end+j])
+				end += j + 1
+				// Two consecutive quotes represent a single quote.
+				if end < len(pattern) && pattern[end] == '\'' {
+					quoted.WriteByte('\'')
+					end++
+					continue
+				}
+				break
+			}
+			if end == i+2 {
+				// An empty quoted literal ('') is a single quote.
+				quoted.WriteByte('\'')
+			}
+			if strings.ContainsAny(quoted.String(), "+-¤0") {
+				return nil, InvalidPatternError{pattern, fmt.Sprintf("unsupported literal %q", quoted.String())}
+			}
+			b.WriteString(quoted.String())
+			literal.WriteString(quoted.String())
+			i = end
+		case r == '¤':
+			if hasCurrency {
+				return nil, InvalidPatternError{pattern, "multiple currency placeholders"}
+			}
+			hasCurrency = true
+			n := 0
+			for strings.HasPrefix(pattern[i:], "¤") {
+				n++
+				i += len("¤")
+			}
+			if n > 2 {
+				return nil, InvalidPatternError{pattern, "unsupported currency placeholder " + strings.Repeat("¤", n)}
+			}
+			b.WriteString(strings.Repeat("¤", n))
+		case r == '#' || r == '0' || r == ',' || r == '.':
+			if hasNumber {
+				return nil, InvalidPatternError{pattern, "multiple numbers"}
+			}
+			hasNumber = true
+			end := i
+			for end < len(pattern) && strings.IndexByte("#0,.", pattern[end]) != -1 {
+				end++
+			}
+			primarySize, secondarySize, err := parseNumberPattern(pattern[i:end])
+			if err != nil {
+				return nil, InvalidPatternError{pattern, err.Error()}
+			}
+			if len(subpatterns) == 0 {
+				// The grouping is defined by the positive pattern.
+				p.primaryGroupingSize = primarySize
+				p.secondaryGroupingSize = secondarySize
+			}
+			b.WriteString("0.00")
+			i = end
+		case r == ';':
+			if len(subpatterns) > 0 {
+				return nil, InvalidPatternError{pattern, "multiple pattern separators"}
+			}
+			if !hasNumber {
+				return nil, InvalidPatternError{pattern, "missing number"}
+			}
+			subpatterns = append(subpatterns, b.String())
+			b.Reset()
+			hasNumber, hasCurrency = false, false
+			i += size
+		case r == '+' || r == '-':
+			b.WriteRune(r)
+			i += size
+		case strings.ContainsRune("%‰E@*", r):
+			return nil, InvalidPatternError{pattern, fmt.Sprintf("unsupported character %q", r)}
+		default:
+			b.WriteRune(r)
+			literal.WriteRune(r)
+			i += size
+		}
+	}
+	p.addLiteral(literal.String())
+	if !hasNumber {
+		return nil, InvalidPatternError{pattern, "missing number"}
+	}
+	subpatterns = append(subpatterns, b.String())
+	p.pattern = strings.Join(subpatterns, ";")
+
+	return p, nil
+}
+
+// parseNumberPattern parses the number part of a pattern ("#,##0.00").
+//
+// Returns the primary and secondary grouping sizes.
+// The fraction digits are ignored, since they are defined by the currency.
+func parseNumberPattern(pattern string) (uint8, uint8, error) {
+	integer, fraction := pattern, ""
+	if i := strings.IndexByte(pattern, '.'); i != -1 {
+		integer, fraction = pattern[:i], pattern[i+1:]
+	}
+	if strings.ContainsAny(fraction, ".,") {
+		return 0, 0, fmt.Errorf("invalid number %q", pattern)
+	}
+	if !strings.HasSuffix(integer, "0") || strings.Contains(integer, ",,") || strings.HasPrefix(integer, ",") {
+		return 0, 0, fmt.Errorf("invalid number %q", pattern)
+	}
+	if strings.Contains(strings.TrimLeft(strings.ReplaceAll(integer, ",", ""), "#"), "#") {
+		return 0, 0, fmt.Errorf("invalid number %q", pattern)
+	}
+	groups := strings.Split(integer, ",")
+	if len(groups) == 1 {
+		return 0, 0, nil
+	}
+	primarySize := len(groups[len(groups)-1])
+	secondarySize := primarySize
+	if len(groups) > 2 {
+		secondarySize = len(groups[len(groups)-2])
+	}
+	if primarySize > 255 || secondarySize > 255 {
+		return 0, 0, fmt.Errorf("invalid number %q", pattern)
+	}
+
+	return uint8(primarySize), uint8(secondarySize), nil
+}
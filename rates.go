@@ -0,0 +1,295 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/apd/v3"
+)
+
+// ExchangeRate represents the exchange rate between two currencies on a given date.
+type ExchangeRate struct {
+	From string
+	To   string
+	// Rate is the exchange rate, as a numeric string.
+	Rate string
+	// Date is the date on which the rate became valid.
+	// The zero value means that the rate is always valid.
+	Date time.Time
+}
+
+// RateTable is a RateProvider backed by a table of exchange rates.
+//
+// Rates can be added directly, or loaded from ECB and CSV rate files.
+// Each currency pair can have multiple rates, one per date, in which
+// case the latest rate valid at the requested time is used.
+// Safe for concurrent use.
+type RateTable struct {
+	mu    sync.RWMutex
+	rates map[string][]ExchangeRate
+}
+
+// NewRateTable creates a new rate table.
+func NewRateTable() *RateTable {
+	return &RateTable{
+		rates: make(map[string][]ExchangeRate),
+	}
+}
+
+// Add adds the given rates to the table.
+//
+// A rate for an already known currency pair and date replaces the existing rate.
+func (t *RateTable) Add(rates ...ExchangeRate) error {
+	for _, rate := range rates {
+		if err := validateRate(rate); err != nil {
+			return err
+		}
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, rate := range rates {
+		pair := rate.From + rate.To
+		pairRates := t.rates[pair]
+		// Keep the rates sorted by date, in ascending order.
+		i := sort.Search(len(pairRates), func(i int) bool {
+			return !pairRates[i].Date.Before(rate.Date)
+		})
+		if i < len(pairRates) && pairRates[i].Date.Equal(rate.Date) {
+			pairRates[i] = rate
+			continue
+		}
+		pairRates = append(pairRates, ExchangeRate{})
+		copy(pairRates[i+1:], pairRates[i:])
+		pairRates[i] = rate
+		t.rates[pair] = pairRates
+	}
+
+	return nil
+}
+
+// Rate implements the RateProvider interface.
+//
+// Returns the latest rate whose date is not after the given time.
+// A zero time returns the latest available rate.
+func (t *RateTable) Rate(from, to string, at time.Time) (string, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	pairRates := t.rates[from+to]
+	if len(pairRates) == 0 {
+		return "", RateNotFoundError{from, to}
+	}
+	if at.IsZero() {
+		return pairRates[len(pairRates)-1].Rate, nil
+	}
+	i := sort.Search(len(pairRates), func(i int) bool {
+		return pairRates[i].Date.After(at)
+	})
+	if i == 0 {
+		return "", RateNotFoundError{from, to}
+	}
+
+	return pairRates[i-1].Rate, nil
+}
+
+// LoadECB loads rates from an ECB euro foreign exchange reference rates file.
+//
+// Both the daily (eurofxref-daily.xml) and the historical (eurofxref-hist.xml,
+// eurofxref-hist-90d.xml) files are supported. All rates are from EUR.
+// Rates for currencies that are no longer in use (e.g. CYP, in the
+// historical file) are skipped.
+func (t *RateTable) LoadECB(r io.Reader) error {
+	aux := struct {
+		Cube struct {
+			Days []struct {
+				Time  string `xml:"time,attr"`
+				Rates []struct {
+					Currency string `xml:"currency,attr"`
+					Rate     string `xml:"rate,attr"`
+				} `xml:"Cube"`
+			} `xml:"Cube"`
+		} `xml:"Cube"`
+	}{}
+	if err := xml.NewDecoder(r).Decode(&aux); err != nil {
+		return fmt.Errorf("ecb: %w", err)
+	}
+	if len(aux.Cube.Days) == 0 {
+		return errors.New("ecb: no rates found")
+	}
+
+	var rates []ExchangeRate
+	for _, day := range aux.Cube.Days {
+		date, err := time.Parse("2006-01-02", day.Time)
+		if err != nil {
+			return fmt.Errorf("ecb: invalid date %q", day.Time)
+		}
+		for _, rate := range day.Rates {
+			if !IsValid(rate.Currency) {
+				continue
+			}
+			rates = append(rates, ExchangeRate{"EUR", rate.Currency, rate.Rate, date})
+		}
+	}
+	if err := t.Add(rates...); err != nil {
+		return fmt.Errorf("ecb: %w", err)
+	}
+
+	return nil
+}
+
+// CSVRateFormat describes the layout of a CSV rate file.
+type CSVRateFormat struct {
+	// Comma is the field delimiter.
+	// Defaults to ','.
+	Comma rune
+	// Header indicates that the first row is a header, and should be skipped.
+	// Defaults to true.
+	Header bool
+	// FromColumn is the index of the column holding the source currency code.
+	// Set to -1 for files without such a column, to use BaseCurrency instead.
+	// Defaults to 0.
+	FromColumn int
+	// ToColumn is the index of the column holding the target currency code.
+	// Defaults to 1.
+	ToColumn int
+	// RateColumn is the index of the column holding the exchange rate.
+	// Defaults to 2.
+	RateColumn int
+	// DateColumn is the index of the column holding the rate date.
+	// Set to -1 for files without dates, making the rates always valid.
+	// Defaults to 3.
+	DateColumn int
+	// DateLayout is the layout of the date, as accepted by time.Parse.
+	// Defaults to "2006-01-02".
+	DateLayout string
+	// DecimalSeparator is the decimal separator used by the rates.
+	// For example, many European banks use "1,0866" instead of "1.0866".
+	// Defaults to ".".
+	DecimalSeparator string
+	// BaseCurrency is the source currency code used when FromColumn is -1.
+	// Defaults to "".
+	BaseCurrency string
+}
+
+// NewCSVRateFormat creates a new CSV rate format with the default layout.
+//
+// The default layout is "from,to,rate,date", with a header row.
+func NewCSVRateFormat() *CSVRateFormat {
+	return &CSVRateFormat{
+		Comma:            ',',
+		Header:           true,
+		FromColumn:       0,
+		ToColumn:         1,
+		RateColumn:       2,
+		DateColumn:       3,
+		DateLayout:       "2006-01-02",
+		DecimalSeparator: ".",
+	}
+}
+
+// LoadCSV loads rates from a CSV rate file with the given format.
+//
+// Each row is validated, and the first invalid row results in an error
+// which includes its line number. No rates are loaded in that case.
+func (t *RateTable) LoadCSV(r io.Reader, format *CSVRateFormat) error {
+	reader := csv.NewReader(r)
+	reader.Comma = format.Comma
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var rates []ExchangeRate
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("csv: %w", err)
+		}
+		if line == 1 && format.Header {
+			continue
+		}
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+		rate, err := format.parseRecord(record)
+		if err != nil {
+			return fmt.Errorf("csv: line %d: %w", line, err)
+		}
+		if err := validateRate(rate); err != nil {
+			return fmt.Errorf("csv: line %d: %w", line, err)
+		}
+		rates = append(rates, rate)
+	}
+	if err := t.Add(rates...); err != nil {
+		return fmt.Errorf("csv: %w", err)
+	}
+
+	return nil
+}
+
+// parseRecord parses a single CSV record into an exchange rate.
+func (format *CSVRateFormat) parseRecord(record []string) (ExchangeRate, error) {
+	field := func(i int) (string, error) {
+		if i >= len(record) {
+			return "", fmt.Errorf("missing column %d", i)
+		}
+		return strings.TrimSpace(record[i]), nil
+	}
+
+	rate := ExchangeRate{From: format.BaseCurrency}
+	var err error
+	if format.FromColumn >= 0 {
+		if rate.From, err = field(format.FromColumn); err != nil {
+			return ExchangeRate{}, err
+		}
+	}
+	if rate.To, err = field(format.ToColumn); err != nil {
+		return ExchangeRate{}, err
+	}
+	if rate.Rate, err = field(format.RateColumn); err != nil {
+		return ExchangeRate{}, err
+	}
+	if format.DecimalSeparator != "" && format.DecimalSeparator != "." {
+		rate.Rate = strings.Replace(rate.Rate, format.DecimalSeparator, ".", 1)
+	}
+	if format.DateColumn >= 0 {
+		date, err := field(format.DateColumn)
+		if err != nil {
+			return ExchangeRate{}, err
+		}
+		if rate.Date, err = time.Parse(format.DateLayout, date); err != nil {
+			return ExchangeRate{}, fmt.Errorf("invalid date %q", date)
+		}
+	}
+
+	return rate, nil
+}
+
+// validateRate validates the currency codes and the rate of an exchange rate.
+func validateRate(rate ExchangeRate) error {
+	if rate.From == "" || !IsValid(rate.From) {
+		return InvalidCurrencyCodeError{rate.From}
+	}
+	if rate.To == "" || !IsValid(rate.To) {
+		return InvalidCurrencyCodeError{rate.To}
+	}
+	number := apd.Decimal{}
+	if _, _, err := number.SetString(rate.Rate); err != nil {
+		return InvalidNumberError{rate.Rate}
+	}
+	if number.Form != apd.Finite || number.Sign() != 1 {
+		return InvalidNumberError{rate.Rate}
+	}
+
+	return nil
+}
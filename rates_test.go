@@ -0,0 +1,239 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/plenigo/currency"
+)
+
+func TestRateTable_Add(t *testing.T) {
+	table := currency.NewRateTable()
+	err := table.Add(currency.ExchangeRate{From: "EUR", To: "usd", Rate: "1.08"})
+	if e, ok := err.(currency.InvalidCurrencyCodeError); ok {
+		if e.CurrencyCode != "usd" {
+			t.Errorf("got %v, want usd", e.CurrencyCode)
+		}
+	} else {
+		t.Errorf("got %T, want currency.InvalidCurrencyCodeError", err)
+	}
+
+	for _, rate := range []string{"INVALID", "0", "-1.08", ""} {
+		err = table.Add(currency.ExchangeRate{From: "EUR", To: "USD", Rate: rate})
+		if e, ok := err.(currency.InvalidNumberError); ok {
+			if e.Number != rate {
+				t.Errorf("got %v, want %v", e.Number, rate)
+			}
+		} else {
+			t.Errorf("got %T, want currency.InvalidNumberError", err)
+		}
+	}
+
+	day1 := time.Date(2024, 5, 16, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2024, 5, 17, 0, 0, 0, 0, time.UTC)
+	day3 := time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC)
+	err = table.Add(
+		currency.ExchangeRate{"EUR", "USD", "1.0866", day2},
+		currency.ExchangeRate{"EUR", "USD", "1.0800", day1},
+		currency.ExchangeRate{"EUR", "USD", "1.0900", day3},
+		// Replaces the first day2 rate.
+		currency.ExchangeRate{"EUR", "USD", "1.0870", day2},
+	)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		at        time.Time
+		want      string
+		wantError string
+	}{
+		{time.Time{}, "1.0900", ""},
+		{day1.Add(-time.Hour), "", `no exchange rate from "EUR" to "USD"`},
+		{day1, "1.0800", ""},
+		{day2, "1.0870", ""},
+		// The weekend uses Friday's rate.
+		{day2.AddDate(0, 0, 2), "1.0870", ""},
+		{day3.AddDate(1, 0, 0), "1.0900", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			got, err := table.Rate("EUR", "USD", tt.at)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			errStr := ""
+			if err != nil {
+				errStr = err.Error()
+			}
+			if errStr != tt.wantError {
+				t.Errorf("error: got %v, want %v", errStr, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestRateTable_LoadECB(t *testing.T) {
+	table := currency.NewRateTable()
+	f, err := os.Open("testdata/eurofxref-daily.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	err = table.LoadECB(f)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rate, err := table.Rate("EUR", "USD", time.Now())
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if rate != "1.0866" {
+		t.Errorf("got %v, want 1.0866", rate)
+	}
+	// The rate is kept as an exact decimal.
+	rate, _ = table.Rate("EUR", "SEK", time.Now())
+	if rate != "11.6410" {
+		t.Errorf("got %v, want 11.6410", rate)
+	}
+	amount, _ := currency.NewAmount("100", "EUR")
+	amount, _ = amount.Convert("IDR", mustRate(t, table, "EUR", "IDR"))
+	if amount.String() != "1737594.00 IDR" {
+		t.Errorf("got %v, want 1737594.00 IDR", amount)
+	}
+
+	table = currency.NewRateTable()
+	f, err = os.Open("testdata/eurofxref-hist.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	err = table.LoadECB(f)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	at := time.Date(2007, 12, 28, 12, 0, 0, 0, time.UTC)
+	rate, _ = table.Rate("EUR", "GBP", at)
+	if rate != "0.7335" {
+		t.Errorf("got %v, want 0.7335", rate)
+	}
+	rate, _ = table.Rate("EUR", "GBP", time.Time{})
+	if rate != "0.73335" {
+		t.Errorf("got %v, want 0.73335", rate)
+	}
+	// CYP is no longer in use, and is skipped.
+	_, err = table.Rate("EUR", "CYP", at)
+	if _, ok := err.(currency.RateNotFoundError); !ok {
+		t.Errorf("got %T, want currency.RateNotFoundError", err)
+	}
+
+	tests := []struct {
+		data      string
+		wantError string
+	}{
+		{"", "ecb: EOF"},
+		{"<Envelope></Envelope>", "ecb: no rates found"},
+		{`<Envelope><Cube><Cube time="17.05.2024"><Cube currency="USD" rate="1.0866"/></Cube></Cube></Envelope>`, `ecb: invalid date "17.05.2024"`},
+		{`<Envelope><Cube><Cube time="2024-05-17"><Cube currency="USD" rate="1,0866"/></Cube></Cube></Envelope>`, `ecb: invalid number "1,0866"`},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			err := currency.NewRateTable().LoadECB(strings.NewReader(tt.data))
+			errStr := ""
+			if err != nil {
+				errStr = err.Error()
+			}
+			if errStr != tt.wantError {
+				t.Errorf("error: got %v, want %v", errStr, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestRateTable_LoadCSV(t *testing.T) {
+	table := currency.NewRateTable()
+	f, err := os.Open("testdata/rates.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	err = table.LoadCSV(f, currency.NewCSVRateFormat())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	at := time.Date(2024, 5, 16, 12, 0, 0, 0, time.UTC)
+	rate, _ := table.Rate("USD", "EUR", at)
+	if rate != "0.9203" {
+		t.Errorf("got %v, want 0.9203", rate)
+	}
+	rate, _ = table.Rate("GBP", "USD", time.Time{})
+	if rate != "1.2681" {
+		t.Errorf("got %v, want 1.2681", rate)
+	}
+
+	// A bank file with semicolons, decimal commas, and no dates.
+	format := currency.NewCSVRateFormat()
+	format.Comma = ';'
+	format.FromColumn = -1
+	format.BaseCurrency = "EUR"
+	format.ToColumn = 0
+	format.RateColumn = 1
+	format.DateColumn = -1
+	format.DecimalSeparator = ","
+	table = currency.NewRateTable()
+	f, err = os.Open("testdata/rates-bank.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	err = table.LoadCSV(f, format)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rate, _ = table.Rate("EUR", "CHF", time.Now())
+	if rate != "0.9868" {
+		t.Errorf("got %v, want 0.9868", rate)
+	}
+
+	tests := []struct {
+		data      string
+		wantError string
+	}{
+		{"from,to,rate,date\nUSD,EUR,0.92,2024-05-17\nUSD,eur,0.92,2024-05-17", `csv: line 3: invalid currency code "eur"`},
+		{"from,to,rate,date\nUSD,EUR,abc,2024-05-17", `csv: line 2: invalid number "abc"`},
+		{"from,to,rate,date\nUSD,EUR,0.92,17.05.2024", `csv: line 2: invalid date "17.05.2024"`},
+		{"from,to,rate,date\nUSD,EUR", `csv: line 2: missing column 2`},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			table := currency.NewRateTable()
+			err := table.LoadCSV(strings.NewReader(tt.data), currency.NewCSVRateFormat())
+			errStr := ""
+			if err != nil {
+				errStr = err.Error()
+			}
+			if errStr != tt.wantError {
+				t.Errorf("error: got %v, want %v", errStr, tt.wantError)
+			}
+			// No rates are loaded from an invalid file.
+			if _, err := table.Rate("USD", "EUR", time.Time{}); err == nil {
+				t.Error("expected no rates to be loaded")
+			}
+		})
+	}
+}
+
+func mustRate(t *testing.T, provider currency.RateProvider, from, to string) string {
+	t.Helper()
+	rate, err := provider.Rate(from, to, time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return rate
+}
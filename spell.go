@@ -0,0 +1,206 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// SpellerNotFoundError is returned when no speller is registered for a locale.
+type SpellerNotFoundError struct {
+	Locale string
+}
+
+func (e SpellerNotFoundError) Error() string {
+	return fmt.Sprintf("no speller found for locale %q", e.Locale)
+}
+
+// Speller spells out amounts in words, in a specific language.
+type Speller interface {
+	// Spell spells out the given amount.
+	//
+	// For example, "123.45 EUR" in English is spelled out as
+	// "one hundred twenty-three euros and forty-five cents".
+	Spell(amount Amount) (string, error)
+}
+
+// SpellerFunc is an adapter allowing the use of ordinary functions as spellers.
+type SpellerFunc func(amount Amount) (string, error)
+
+// Spell implements the Speller interface.
+func (f SpellerFunc) Spell(amount Amount) (string, error) {
+	return f(amount)
+}
+
+var spellers = struct {
+	sync.RWMutex
+	m map[string]Speller
+}{
+	m: map[string]Speller{
+		"de":    deSpeller,
+		"en":    enSpeller,
+		"en-IN": enINSpeller,
+		"es":    esSpeller,
+		"fr":    frSpeller,
+		"pl":    plSpeller,
+	},
+}
+
+// RegisterSpeller registers a speller for the given locale ID (e.g. "it", "en-IN").
+//
+// The speller is also used for child locales without their own speller
+// (e.g. a speller registered for "it" is used for "it-CH").
+// Replaces any previously registered speller, including built-in ones.
+func RegisterSpeller(localeID string, speller Speller) {
+	spellers.Lock()
+	defer spellers.Unlock()
+	spellers.m[localeID] = speller
+}
+
+// SpellOut spells out the amount in words, in the given locale.
+//
+// Built-in spellers are provided for the following languages: English
+// ("en", plus "en-IN" which uses lakhs and crores), German ("de"),
+// French ("fr"), Spanish ("es") and Polish ("pl"). Others can be
+// added via RegisterSpeller.
+//
+// The amount is rounded to the currency's number of fraction digits.
+// The major and minor units are spelled out using their names,
+// e.g. "euros" and "cents". Currencies whose unit names are unknown
+// to the speller use the currency code instead, with the minor units
+// shown as a fraction ("one hundred twenty-three SEK and 45/100").
+func SpellOut(amount Amount, locale Locale) (string, error) {
+	locale = locale.base()
+	if locale.IsEmpty() {
+		locale = Locale{Language: "en"}
+	}
+	language := locale.Language
+	speller := findSpeller(locale)
+	if speller == nil {
+		return "", SpellerNotFoundError{language}
+	}
+	// Called without holding the lock, so that spellers can call
+	// RegisterSpeller or SpellOut themselves.
+	return speller.Spell(amount)
+}
+
+// findSpeller returns the speller registered for the locale or its parents.
+func findSpeller(locale Locale) Speller {
+	spellers.RLock()
+	defer spellers.RUnlock()
+	language := locale.Language
+	// Stop at the language boundary, to avoid falling back to English.
+	for !locale.IsEmpty() && locale.Language == language {
+		if speller, ok := spellers.m[locale.String()]; ok {
+			return speller
+		}
+		locale = locale.GetParent()
+	}
+
+	return nil
+}
+
+// gender is the grammatical gender of a currency unit.
+type gender uint8
+
+const (
+	masculine gender = iota
+	feminine
+	neuter
+)
+
+// spellUnit is a currency unit used by spellers (e.g. "euro" or "cent").
+type spellUnit struct {
+	// forms maps plural categories ("one", "few", "many", "other") to unit names.
+	forms  map[string]string
+	gender gender
+}
+
+// languageSpeller is a Speller built from a language's number words and unit names.
+type languageSpeller struct {
+	// spellNumber spells out n, agreeing with the given gender.
+	spellNumber func(n uint64, g gender) string
+	// pluralCategory returns the plural category of n.
+	pluralCategory func(n uint64) string
+	// units maps currency codes to their major and minor units.
+	units map[string][2]spellUnit
+	// minus is the word used for negative amounts.
+	minus string
+	// and joins the major and minor parts (e.g. " and ").
+	and string
+}
+
+// Spell implements the Speller interface.
+func (s languageSpeller) Spell(amount Amount) (string, error) {
+	if amount.currencyCode == "" {
+		return "", InvalidCurrencyCodeError{amount.currencyCode}
+	}
+	digits, _ := GetDigits(amount.currencyCode)
+	amount = amount.Round()
+	number := strings.TrimPrefix(amount.Number(), "-")
+	majorDigits, minorDigits := number, ""
+	if i := strings.IndexByte(number, '.'); i != -1 {
+		majorDigits, minorDigits = number[:i], number[i+1:]
+	}
+	major, err := strconv.ParseUint(majorDigits, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("spell out: %v is out of range", amount.Number())
+	}
+	var minor uint64
+	if minorDigits != "" {
+		minor, _ = strconv.ParseUint(minorDigits, 10, 64)
+	}
+
+	b := strings.Builder{}
+	if amount.IsNegative() {
+		b.WriteString(s.minus)
+		b.WriteString(" ")
+	}
+	units, ok := s.units[amount.currencyCode]
+	if !ok {
+		b.WriteString(s.spellNumber(major, masculine))
+		b.WriteString(" ")
+		b.WriteString(amount.currencyCode)
+		if minor > 0 {
+			b.WriteString(s.and)
+			fmt.Fprintf(&b, "%0*d/1%s", digits, minor, strings.Repeat("0", int(digits)))
+		}
+		return b.String(), nil
+	}
+	b.WriteString(s.spellUnit(major, units[0]))
+	if minor > 0 {
+		b.WriteString(s.and)
+		b.WriteString(s.spellUnit(minor, units[1]))
+	}
+
+	return b.String(), nil
+}
+
+// spellUnit spells out n followed by the matching unit name.
+func (s languageSpeller) spellUnit(n uint64, unit spellUnit) string {
+	name, ok := unit.forms[s.pluralCategory(n)]
+	if !ok {
+		name = unit.forms["other"]
+	}
+
+	return s.spellNumber(n, unit.gender) + " " + name
+}
+
+// spellScale is a named power of ten used for spelling out large numbers.
+type spellScale struct {
+	value uint64
+	// forms maps plural categories to scale names (e.g. "million", "millions").
+	forms map[string]string
+}
+
+// name returns the scale name for the given plural category.
+func (s spellScale) name(category string) string {
+	if name, ok := s.forms[category]; ok {
+		return name
+	}
+	return s.forms["other"]
+}
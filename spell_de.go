@@ -0,0 +1,121 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency
+
+import "strings"
+
+var deOnes = []string{
+	"null", "eins", "zwei", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun",
+	"zehn", "elf", "zwölf", "dreizehn", "vierzehn", "fünfzehn", "sechzehn",
+	"siebzehn", "achtzehn", "neunzehn",
+}
+
+var deTens = []string{
+	"", "", "zwanzig", "dreißig", "vierzig", "fünfzig", "sechzig", "siebzig", "achtzig", "neunzig",
+}
+
+var deScales = []spellScale{
+	{1e18, map[string]string{"one": "Trillion", "other": "Trillionen"}},
+	{1e15, map[string]string{"one": "Billiarde", "other": "Billiarden"}},
+	{1e12, map[string]string{"one": "Billion", "other": "Billionen"}},
+	{1e9, map[string]string{"one": "Milliarde", "other": "Milliarden"}},
+	{1e6, map[string]string{"one": "Million", "other": "Millionen"}},
+}
+
+var deUnits = map[string][2]spellUnit{
+	"AUD": {deUnit("Australischer Dollar", "Australische Dollar", masculine), deUnit("Cent", "Cent", masculine)},
+	"CAD": {deUnit("Kanadischer Dollar", "Kanadische Dollar", masculine), deUnit("Cent", "Cent", masculine)},
+	"CHF": {deUnit("Schweizer Franken", "Schweizer Franken", masculine), deUnit("Rappen", "Rappen", masculine)},
+	"EUR": {deUnit("Euro", "Euro", masculine), deUnit("Cent", "Cent", masculine)},
+	"GBP": {deUnit("Pfund Sterling", "Pfund Sterling", neuter), deUnit("Penny", "Pence", masculine)},
+	"INR": {deUnit("Indische Rupie", "Indische Rupien", feminine), deUnit("Paisa", "Paise", feminine)},
+	"JPY": {deUnit("Yen", "Yen", masculine), deUnit("Sen", "Sen", masculine)},
+	"PLN": {deUnit("Złoty", "Złoty", masculine), deUnit("Grosz", "Groszy", masculine)},
+	"USD": {deUnit("US-Dollar", "US-Dollar", masculine), deUnit("Cent", "Cent", masculine)},
+}
+
+var deSpeller = languageSpeller{
+	spellNumber:    deSpellNumber,
+	pluralCategory: enPluralCategory,
+	units:          deUnits,
+	minus:          "minus",
+	and:            " und ",
+}
+
+func deUnit(one, other string, g gender) spellUnit {
+	return spellUnit{forms: map[string]string{"one": one, "other": other}, gender: g}
+}
+
+func deSpellNumber(n uint64, g gender) string {
+	if n == 0 {
+		return deOnes[0]
+	}
+	if n == 1 {
+		if g == feminine {
+			return "eine"
+		}
+		return "ein"
+	}
+	var words []string
+	for _, scale := range deScales {
+		count := n / scale.value
+		if count == 1 {
+			words = append(words, "eine", scale.name("one"))
+		} else if count > 1 {
+			words = append(words, deSpellBelowMillion(count), scale.name("other"))
+		}
+		n %= scale.value
+	}
+	if n > 0 {
+		words = append(words, deSpellBelowMillion(n))
+	}
+
+	return strings.Join(words, " ")
+}
+
+// deSpellBelowMillion spells out a number between 1 and 999999, as a single word.
+func deSpellBelowMillion(n uint64) string {
+	word := ""
+	if n >= 1000 {
+		word = deSpellBelowThousand(n/1000, true) + "tausend"
+		n %= 1000
+	}
+	if n > 0 {
+		word += deSpellBelowThousand(n, false)
+	}
+
+	return word
+}
+
+// deSpellBelowThousand spells out a number between 1 and 999.
+//
+// Prefixes use "ein" instead of "eins" ("eintausend").
+func deSpellBelowThousand(n uint64, prefix bool) string {
+	word := ""
+	if n >= 100 {
+		word = deDigitPrefix(n/100) + "hundert"
+		n %= 100
+	}
+	switch {
+	case n == 1 && prefix:
+		word += "ein"
+	case n >= 20:
+		if n%10 != 0 {
+			word += deDigitPrefix(n%10) + "und"
+		}
+		word += deTens[n/10]
+	case n > 0:
+		word += deOnes[n]
+	}
+
+	return word
+}
+
+// deDigitPrefix returns the form of a digit used as a prefix ("ein", "zwei").
+func deDigitPrefix(digit uint64) string {
+	if digit == 1 {
+		return "ein"
+	}
+	return deOnes[digit]
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency
+
+import "strings"
+
+var enOnes = []string{
+	"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+	"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen",
+	"seventeen", "eighteen", "nineteen",
+}
+
+var enTens = []string{
+	"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety",
+}
+
+var enScales = []spellScale{
+	{1e18, map[string]string{"other": "quintillion"}},
+	{1e15, map[string]string{"other": "quadrillion"}},
+	{1e12, map[string]string{"other": "trillion"}},
+	{1e9, map[string]string{"other": "billion"}},
+	{1e6, map[string]string{"other": "million"}},
+	{1e3, map[string]string{"other": "thousand"}},
+}
+
+var enUnits = map[string][2]spellUnit{
+	"AUD": {enUnit("Australian dollar", "Australian dollars"), enUnit("cent", "cents")},
+	"CAD": {enUnit("Canadian dollar", "Canadian dollars"), enUnit("cent", "cents")},
+	"CHF": {enUnit("Swiss franc", "Swiss francs"), enUnit("centime", "centimes")},
+	"EUR": {enUnit("euro", "euros"), enUnit("cent", "cents")},
+	"GBP": {enUnit("pound sterling", "pounds sterling"), enUnit("penny", "pence")},
+	"INR": {enUnit("rupee", "rupees"), enUnit("paisa", "paise")},
+	"JPY": {enUnit("yen", "yen"), enUnit("sen", "sen")},
+	"PLN": {enUnit("zloty", "zlotys"), enUnit("grosz", "groszy")},
+	"USD": {enUnit("dollar", "dollars"), enUnit("cent", "cents")},
+}
+
+var enSpeller = languageSpeller{
+	spellNumber:    enSpellNumber,
+	pluralCategory: enPluralCategory,
+	units:          enUnits,
+	minus:          "minus",
+	and:            " and ",
+}
+
+// enINSpeller uses the Indian numbering system (lakhs and crores).
+var enINSpeller = languageSpeller{
+	spellNumber:    enINSpellNumber,
+	pluralCategory: enPluralCategory,
+	units:          enUnits,
+	minus:          "minus",
+	and:            " and ",
+}
+
+func enUnit(one, other string) spellUnit {
+	return spellUnit{forms: map[string]string{"one": one, "other": other}}
+}
+
+func enPluralCategory(n uint64) string {
+	if n == 1 {
+		return "one"
+	}
+	return "other"
+}
+
+func enSpellNumber(n uint64, g gender) string {
+	if n == 0 {
+		return enOnes[0]
+	}
+	var words []string
+	for _, scale := range enScales {
+		if n >= scale.value {
+			words = append(words, enSpellBelowThousand(n/scale.value), scale.name("other"))
+			n %= scale.value
+		}
+	}
+	if n > 0 {
+		words = append(words, enSpellBelowThousand(n))
+	}
+
+	return strings.Join(words, " ")
+}
+
+func enINSpellNumber(n uint64, g gender) string {
+	if n == 0 {
+		return enOnes[0]
+	}
+	var words []string
+	if n >= 1e7 {
+		// Larger numbers are expressed in crores ("one lakh crore").
+		words = append(words, enINSpellNumber(n/1e7, g), "crore")
+		n %= 1e7
+	}
+	if n >= 1e5 {
+		words = append(words, enSpellBelowThousand(n/1e5), "lakh")
+		n %= 1e5
+	}
+	if n >= 1e3 {
+		words = append(words, enSpellBelowThousand(n/1e3), "thousand")
+		n %= 1e3
+	}
+	if n > 0 {
+		words = append(words, enSpellBelowThousand(n))
+	}
+
+	return strings.Join(words, " ")
+}
+
+// enSpellBelowThousand spells out a number between 1 and 999.
+func enSpellBelowThousand(n uint64) string {
+	var words []string
+	if n >= 100 {
+		words = append(words, enOnes[n/100], "hundred")
+		n %= 100
+	}
+	if n >= 20 {
+		word := enTens[n/10]
+		if n%10 != 0 {
+			word += "-" + enOnes[n%10]
+		}
+		words = append(words, word)
+	} else if n > 0 {
+		words = append(words, enOnes[n])
+	}
+
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency
+
+import "strings"
+
+var esOnes = []string{
+	"cero", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve",
+	"diez", "once", "doce", "trece", "catorce", "quince", "dieciséis",
+	"diecisiete", "dieciocho", "diecinueve",
+}
+
+var esTwenties = []string{
+	"veinte", "veintiuno", "veintidós", "veintitrés", "veinticuatro",
+	"veinticinco", "veintiséis", "veintisiete", "veintiocho", "veintinueve",
+}
+
+var esTens = []string{
+	"", "", "veinte", "treinta", "cuarenta", "cincuenta", "sesenta", "setenta", "ochenta", "noventa",
+}
+
+var esHundreds = []string{
+	"", "ciento", "doscientos", "trescientos", "cuatrocientos",
+	"quinientos", "seiscientos", "setecientos", "ochocientos", "novecientos",
+}
+
+// Spanish uses the long scale ("mil millones" for 10^9).
+var esScales = []spellScale{
+	{1e18, map[string]string{"one": "trillón", "other": "trillones"}},
+	{1e12, map[string]string{"one": "billón", "other": "billones"}},
+	{1e6, map[string]string{"one": "millón", "other": "millones"}},
+}
+
+var esUnits = map[string][2]spellUnit{
+	"AUD": {esUnit("dólar australiano", "dólares australianos", masculine), esUnit("centavo", "centavos", masculine)},
+	"CAD": {esUnit("dólar canadiense", "dólares canadienses", masculine), esUnit("centavo", "centavos", masculine)},
+	"CHF": {esUnit("franco suizo", "francos suizos", masculine), esUnit("céntimo", "céntimos", masculine)},
+	"EUR": {esUnit("euro", "euros", masculine), esUnit("céntimo", "céntimos", masculine)},
+	"GBP": {esUnit("libra esterlina", "libras esterlinas", feminine), esUnit("penique", "peniques", masculine)},
+	"INR": {esUnit("rupia india", "rupias indias", feminine), esUnit("paisa", "paisas", feminine)},
+	"JPY": {esUnit("yen", "yenes", masculine), esUnit("sen", "sen", masculine)},
+	"PLN": {esUnit("esloti", "eslotis", masculine), esUnit("grosz", "groszy", masculine)},
+	"USD": {esUnit("dólar estadounidense", "dólares estadounidenses", masculine), esUnit("centavo", "centavos", masculine)},
+}
+
+var esSpeller = languageSpeller{
+	spellNumber:    esSpellNumber,
+	pluralCategory: esPluralCategory,
+	units:          esUnits,
+	minus:          "menos",
+	and:            " con ",
+}
+
+// esUnit creates a Spanish unit. The "many" form is used after round
+// millions and larger ("un millón de euros").
+func esUnit(one, other string, g gender) spellUnit {
+	forms := map[string]string{"one": one, "other": other, "many": "de " + other}
+	return spellUnit{forms: forms, gender: g}
+}
+
+func esPluralCategory(n uint64) string {
+	switch {
+	case n == 1:
+		return "one"
+	case n != 0 && n%1e6 == 0:
+		return "many"
+	default:
+		return "other"
+	}
+}
+
+func esSpellNumber(n uint64, g gender) string {
+	if n == 0 {
+		return esOnes[0]
+	}
+	var words []string
+	for _, scale := range esScales {
+		count := n / scale.value
+		if count == 1 {
+			words = append(words, "un", scale.name("one"))
+		} else if count > 1 {
+			// Scales are masculine ("veintiún millones").
+			words = append(words, esSpellBelowMillion(count, masculine), scale.name("other"))
+		}
+		n %= scale.value
+	}
+	if n > 0 {
+		words = append(words, esSpellBelowMillion(n, g))
+	}
+
+	return strings.Join(words, " ")
+}
+
+// esSpellBelowMillion spells out a number between 1 and 999999.
+func esSpellBelowMillion(n uint64, g gender) string {
+	var words []string
+	if n >= 1000 {
+		count := n / 1000
+		if count > 1 {
+			words = append(words, esSpellBelowThousand(count, g))
+		}
+		words = append(words, "mil")
+		n %= 1000
+	}
+	if n > 0 {
+		words = append(words, esSpellBelowThousand(n, g))
+	}
+
+	return strings.Join(words, " ")
+}
+
+// esSpellBelowThousand spells out a number between 1 and 999,
+// agreeing with the gender of the following noun.
+func esSpellBelowThousand(n uint64, g gender) string {
+	var words []string
+	hundreds, rest := n/100, n%100
+	if hundreds == 1 && rest == 0 {
+		words = append(words, "cien")
+	} else if hundreds > 0 {
+		word := esHundreds[hundreds]
+		if g == feminine && hundreds > 1 {
+			word = strings.TrimSuffix(word, "os") + "as"
+		}
+		words = append(words, word)
+	}
+	switch {
+	case rest >= 30:
+		word := esTens[rest/10]
+		if rest%10 != 0 {
+			word += " y " + esOnes[rest%10]
+		}
+		words = append(words, word)
+	case rest >= 20:
+		words = append(words, esTwenties[rest-20])
+	case rest > 0:
+		words = append(words, esOnes[rest])
+	}
+	spelled := strings.Join(words, " ")
+	// "Uno" is shortened before nouns ("veintiún euros", "una libra").
+	if strings.HasSuffix(spelled, "uno") {
+		spelled = strings.TrimSuffix(spelled, "o")
+		if g == feminine {
+			spelled += "a"
+		} else if strings.HasSuffix(spelled, "veintiun") {
+			spelled = strings.TrimSuffix(spelled, "un") + "ún"
+		}
+	}
+
+	return spelled
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency
+
+import "strings"
+
+var frOnes = []string{
+	"zéro", "un", "deux", "trois", "quatre", "cinq", "six", "sept", "huit", "neuf",
+	"dix", "onze", "douze", "treize", "quatorze", "quinze", "seize",
+	"dix-sept", "dix-huit", "dix-neuf",
+}
+
+var frTens = []string{
+	"", "", "vingt", "trente", "quarante", "cinquante", "soixante",
+}
+
+var frScales = []spellScale{
+	{1e18, map[string]string{"one": "trillion", "other": "trillions"}},
+	{1e15, map[string]string{"one": "billiard", "other": "billiards"}},
+	{1e12, map[string]string{"one": "billion", "other": "billions"}},
+	{1e9, map[string]string{"one": "milliard", "other": "milliards"}},
+	{1e6, map[string]string{"one": "million", "other": "millions"}},
+}
+
+var frUnits = map[string][2]spellUnit{
+	"AUD": {frUnit("dollar australien", "dollars australiens", "de dollars australiens", masculine), frUnit("cent", "cents", "", masculine)},
+	"CAD": {frUnit("dollar canadien", "dollars canadiens", "de dollars canadiens", masculine), frUnit("cent", "cents", "", masculine)},
+	"CHF": {frUnit("franc suisse", "francs suisses", "de francs suisses", masculine), frUnit("centime", "centimes", "", masculine)},
+	"EUR": {frUnit("euro", "euros", "d'euros", masculine), frUnit("centime", "centimes", "", masculine)},
+	"GBP": {frUnit("livre sterling", "livres sterling", "de livres sterling", feminine), frUnit("penny", "pence", "", masculine)},
+	"INR": {frUnit("roupie indienne", "roupies indiennes", "de roupies indiennes", feminine), frUnit("paisa", "paise", "", masculine)},
+	"JPY": {frUnit("yen", "yens", "de yens", masculine), frUnit("sen", "sens", "", masculine)},
+	"PLN": {frUnit("zloty", "zlotys", "de zlotys", masculine), frUnit("grosz", "groszy", "", masculine)},
+	"USD": {frUnit("dollar des États-Unis", "dollars des États-Unis", "de dollars des États-Unis", masculine), frUnit("cent", "cents", "", masculine)},
+}
+
+var frSpeller = languageSpeller{
+	spellNumber:    frSpellNumber,
+	pluralCategory: frPluralCategory,
+	units:          frUnits,
+	minus:          "moins",
+	and:            " et ",
+}
+
+// frUnit creates a French unit. The "many" form is used after round
+// millions and larger ("un million d'euros").
+func frUnit(one, other, many string, g gender) spellUnit {
+	forms := map[string]string{"one": one, "other": other}
+	if many != "" {
+		forms["many"] = many
+	}
+	return spellUnit{forms: forms, gender: g}
+}
+
+func frPluralCategory(n uint64) string {
+	switch {
+	case n == 0 || n == 1:
+		return "one"
+	case n%1e6 == 0:
+		return "many"
+	default:
+		return "other"
+	}
+}
+
+func frSpellNumber(n uint64, g gender) string {
+	if n == 0 {
+		return frOnes[0]
+	}
+	var words []string
+	for _, scale := range frScales {
+		count := n / scale.value
+		if count == 1 {
+			words = append(words, "un", scale.name("one"))
+		} else if count > 1 {
+			words = append(words, frSpellBelowMillion(count, true), scale.name("other"))
+		}
+		n %= scale.value
+	}
+	if n > 0 {
+		words = append(words, frSpellBelowMillion(n, true))
+	}
+	spelled := strings.Join(words, " ")
+	if g == feminine && strings.HasSuffix(spelled, "un") {
+		spelled += "e"
+	}
+
+	return spelled
+}
+
+// frSpellBelowMillion spells out a number between 1 and 999999.
+//
+// Final numbers take the plural forms "vingts" and "cents" when
+// they are a multiple of 20 and 100 respectively ("quatre-vingts").
+func frSpellBelowMillion(n uint64, final bool) string {
+	var words []string
+	if n >= 1000 {
+		count := n / 1000
+		if count > 1 {
+			// Never plural before "mille" ("deux cent mille").
+			words = append(words, frSpellBelowThousand(count, false))
+		}
+		words = append(words, "mille")
+		n %= 1000
+	}
+	if n > 0 {
+		words = append(words, frSpellBelowThousand(n, final))
+	}
+
+	return strings.Join(words, " ")
+}
+
+// frSpellBelowThousand spells out a number between 1 and 999.
+func frSpellBelowThousand(n uint64, final bool) string {
+	var words []string
+	hundreds, rest := n/100, n%100
+	if hundreds == 1 {
+		words = append(words, "cent")
+	} else if hundreds > 1 {
+		word := frOnes[hundreds] + " cent"
+		if rest == 0 && final {
+			word += "s"
+		}
+		words = append(words, word)
+	}
+	if rest > 0 {
+		words = append(words, frSpellBelowHundred(rest, final))
+	}
+
+	return strings.Join(words, " ")
+}
+
+// frSpellBelowHundred spells out a number between 1 and 99.
+func frSpellBelowHundred(n uint64, final bool) string {
+	if n < 20 {
+		return frOnes[n]
+	}
+	tens, ones := n/10, n%10
+	switch tens {
+	case 7:
+		// 70-79 are "soixante-dix" to "soixante-dix-neuf".
+		if ones == 1 {
+			return "soixante et onze"
+		}
+		return "soixante-" + frOnes[10+ones]
+	case 8:
+		if ones == 0 {
+			if final {
+				return "quatre-vingts"
+			}
+			return "quatre-vingt"
+		}
+		return "quatre-vingt-" + frOnes[ones]
+	case 9:
+		return "quatre-vingt-" + frOnes[10+ones]
+	}
+	switch ones {
+	case 0:
+		return frTens[tens]
+	case 1:
+		return frTens[tens] + " et un"
+	default:
+		return frTens[tens] + "-" + frOnes[ones]
+	}
+}
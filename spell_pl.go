@@ -0,0 +1,116 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency
+
+import "strings"
+
+var plOnes = []string{
+	"zero", "jeden", "dwa", "trzy", "cztery", "pięć", "sześć", "siedem", "osiem", "dziewięć",
+	"dziesięć", "jedenaście", "dwanaście", "trzynaście", "czternaście", "piętnaście",
+	"szesnaście", "siedemnaście", "osiemnaście", "dziewiętnaście",
+}
+
+var plTens = []string{
+	"", "", "dwadzieścia", "trzydzieści", "czterdzieści", "pięćdziesiąt",
+	"sześćdziesiąt", "siedemdziesiąt", "osiemdziesiąt", "dziewięćdziesiąt",
+}
+
+var plHundreds = []string{
+	"", "sto", "dwieście", "trzysta", "czterysta",
+	"pięćset", "sześćset", "siedemset", "osiemset", "dziewięćset",
+}
+
+var plScales = []spellScale{
+	{1e18, map[string]string{"one": "trylion", "few": "tryliony", "many": "trylionów"}},
+	{1e15, map[string]string{"one": "biliard", "few": "biliardy", "many": "biliardów"}},
+	{1e12, map[string]string{"one": "bilion", "few": "biliony", "many": "bilionów"}},
+	{1e9, map[string]string{"one": "miliard", "few": "miliardy", "many": "miliardów"}},
+	{1e6, map[string]string{"one": "milion", "few": "miliony", "many": "milionów"}},
+	{1e3, map[string]string{"one": "tysiąc", "few": "tysiące", "many": "tysięcy"}},
+}
+
+var plUnits = map[string][2]spellUnit{
+	"AUD": {plUnit("dolar australijski", "dolary australijskie", "dolarów australijskich", masculine), plUnit("cent", "centy", "centów", masculine)},
+	"CAD": {plUnit("dolar kanadyjski", "dolary kanadyjskie", "dolarów kanadyjskich", masculine), plUnit("cent", "centy", "centów", masculine)},
+	"CHF": {plUnit("frank szwajcarski", "franki szwajcarskie", "franków szwajcarskich", masculine), plUnit("centym", "centymy", "centymów", masculine)},
+	"EUR": {plUnit("euro", "euro", "euro", neuter), plUnit("cent", "centy", "centów", masculine)},
+	"GBP": {plUnit("funt szterling", "funty szterlingi", "funtów szterlingów", masculine), plUnit("pens", "pensy", "pensów", masculine)},
+	"INR": {plUnit("rupia indyjska", "rupie indyjskie", "rupii indyjskich", feminine), plUnit("pajsa", "pajsy", "pajs", feminine)},
+	"JPY": {plUnit("jen", "jeny", "jenów", masculine), plUnit("sen", "seny", "senów", masculine)},
+	"PLN": {plUnit("złoty", "złote", "złotych", masculine), plUnit("grosz", "grosze", "groszy", masculine)},
+	"USD": {plUnit("dolar amerykański", "dolary amerykańskie", "dolarów amerykańskich", masculine), plUnit("cent", "centy", "centów", masculine)},
+}
+
+var plSpeller = languageSpeller{
+	spellNumber:    plSpellNumber,
+	pluralCategory: plPluralCategory,
+	units:          plUnits,
+	minus:          "minus",
+	and:            " ",
+}
+
+func plUnit(one, few, many string, g gender) spellUnit {
+	forms := map[string]string{"one": one, "few": few, "many": many, "other": many}
+	return spellUnit{forms: forms, gender: g}
+}
+
+func plPluralCategory(n uint64) string {
+	switch {
+	case n == 1:
+		return "one"
+	case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+		return "few"
+	default:
+		return "many"
+	}
+}
+
+func plSpellNumber(n uint64, g gender) string {
+	switch {
+	case n == 0:
+		return plOnes[0]
+	case n == 1 && g == feminine:
+		return "jedna"
+	case n == 1 && g == neuter:
+		return "jedno"
+	}
+	var words []string
+	for _, scale := range plScales {
+		count := n / scale.value
+		if count == 1 {
+			words = append(words, scale.name("one"))
+		} else if count > 1 {
+			words = append(words, plSpellBelowThousand(count, masculine), scale.name(plPluralCategory(count)))
+		}
+		n %= scale.value
+	}
+	if n > 0 {
+		words = append(words, plSpellBelowThousand(n, g))
+	}
+
+	return strings.Join(words, " ")
+}
+
+// plSpellBelowThousand spells out a number between 1 and 999,
+// agreeing with the gender of the following noun.
+func plSpellBelowThousand(n uint64, g gender) string {
+	var words []string
+	hundreds, rest := n/100, n%100
+	if hundreds > 0 {
+		words = append(words, plHundreds[hundreds])
+	}
+	if rest >= 20 {
+		words = append(words, plTens[rest/10])
+		rest %= 10
+	}
+	if rest > 0 {
+		if rest == 2 && g == feminine {
+			words = append(words, "dwie")
+		} else {
+			words = append(words, plOnes[rest])
+		}
+	}
+
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2020 Bojan Zivanovic and contributors
+// SPDX-License-Identifier: MIT
+
+package currency_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/plenigo/currency"
+)
+
+func TestSpellOut(t *testing.T) {
+	tests := []struct {
+		number       string
+		currencyCode string
+		localeID     string
+		want         string
+	}{
+		{"123.45", "EUR", "en", "one hundred twenty-three euros and forty-five cents"},
+		{"1", "USD", "en-US", "one dollar"},
+		{"0.01", "USD", "en", "zero dollars and one cent"},
+		{"-21.50", "GBP", "en-GB", "minus twenty-one pounds sterling and fifty pence"},
+		{"1000000", "USD", "", "one million dollars"},
+		{"2005017.999", "USD", "en", "two million five thousand eighteen dollars"},
+		{"18446744073709551615", "JPY", "en", "eighteen quintillion four hundred forty-six quadrillion seven hundred forty-four trillion seventy-three billion seven hundred nine million five hundred fifty-one thousand six hundred fifteen yen"},
+		{"12345678.50", "INR", "en-IN", "one crore twenty-three lakh forty-five thousand six hundred seventy-eight rupees and fifty paise"},
+		{"1234500000000", "INR", "en-IN", "one lakh twenty-three thousand four hundred fifty crore rupees"},
+		{"123.45", "SEK", "en", "one hundred twenty-three SEK and 45/100"},
+		{"1.5", "KWD", "en", "one KWD and 500/1000"},
+
+		{"1", "EUR", "de", "ein Euro"},
+		{"1", "INR", "de", "eine Indische Rupie"},
+		{"123.45", "EUR", "de-AT", "einhundertdreiundzwanzig Euro und fünfundvierzig Cent"},
+		{"1001", "CHF", "de-CH", "eintausendeins Schweizer Franken"},
+		{"71000", "EUR", "de", "einundsiebzigtausend Euro"},
+		{"1200000", "EUR", "de", "eine Million zweihunderttausend Euro"},
+		{"3000000000", "EUR", "de", "drei Milliarden Euro"},
+
+		{"1", "EUR", "fr", "un euro"},
+		{"0.50", "EUR", "fr", "zéro euro et cinquante centimes"},
+		{"21", "GBP", "fr", "vingt et une livres sterling"},
+		{"71.80", "EUR", "fr", "soixante et onze euros et quatre-vingts centimes"},
+		{"81", "EUR", "fr-CH", "quatre-vingt-un euros"},
+		{"99", "EUR", "fr", "quatre-vingt-dix-neuf euros"},
+		{"200", "EUR", "fr", "deux cents euros"},
+		{"280000", "EUR", "fr", "deux cent quatre-vingt mille euros"},
+		{"1000", "EUR", "fr", "mille euros"},
+		{"1000000", "EUR", "fr", "un million d'euros"},
+		{"2200000", "CHF", "fr", "deux millions deux cent mille francs suisses"},
+
+		{"1", "EUR", "es", "un euro"},
+		{"21", "EUR", "es-MX", "veintiún euros"},
+		{"21", "GBP", "es", "veintiuna libras esterlinas"},
+		{"100", "EUR", "es", "cien euros"},
+		{"101.31", "USD", "es", "ciento un dólares estadounidenses con treinta y un centavos"},
+		{"500", "GBP", "es", "quinientas libras esterlinas"},
+		{"21000", "EUR", "es", "veintiún mil euros"},
+		{"1000000", "EUR", "es", "un millón de euros"},
+		{"1000000000", "EUR", "es", "mil millones de euros"},
+		{"2000000000000", "EUR", "es", "dos billones de euros"},
+
+		{"1", "PLN", "pl", "jeden złoty"},
+		{"1", "EUR", "pl", "jedno euro"},
+		{"2", "INR", "pl", "dwie rupie indyjskie"},
+		{"123.45", "PLN", "pl", "sto dwadzieścia trzy złote czterdzieści pięć groszy"},
+		{"12", "PLN", "pl", "dwanaście złotych"},
+		{"22", "PLN", "pl", "dwadzieścia dwa złote"},
+		{"1000", "PLN", "pl", "tysiąc złotych"},
+		{"2000", "PLN", "pl", "dwa tysiące złotych"},
+		{"5000000", "PLN", "pl", "pięć milionów złotych"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			amount, _ := currency.NewAmount(tt.number, tt.currencyCode)
+			got, err := currency.SpellOut(amount, currency.NewLocale(tt.localeID))
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpellOut_Errors(t *testing.T) {
+	amount, _ := currency.NewAmount("10", "EUR")
+	_, err := currency.SpellOut(amount, currency.NewLocale("it-CH"))
+	if e, ok := err.(currency.SpellerNotFoundError); ok {
+		if e.Locale != "it" {
+			t.Errorf("got %v, want it", e.Locale)
+		}
+		wantError := `no speller found for locale "it"`
+		if e.Error() != wantError {
+			t.Errorf("got %v, want %v", e.Error(), wantError)
+		}
+	} else {
+		t.Errorf("got %T, want currency.SpellerNotFoundError", err)
+	}
+
+	_, err = currency.SpellOut(currency.Amount{}, currency.NewLocale("en"))
+	if _, ok := err.(currency.InvalidCurrencyCodeError); !ok {
+		t.Errorf("got %T, want currency.InvalidCurrencyCodeError", err)
+	}
+
+	amount, _ = currency.NewAmount("18446744073709551616", "EUR")
+	_, err = currency.SpellOut(amount, currency.NewLocale("en"))
+	if err == nil {
+		t.Error("expected an error for an out of range amount")
+	}
+}
+
+func TestRegisterSpeller(t *testing.T) {
+	currency.RegisterSpeller("it", currency.SpellerFunc(func(amount currency.Amount) (string, error) {
+		return strings.Replace(amount.String(), ".", " virgola ", 1), nil
+	}))
+	amount, _ := currency.NewAmount("10.50", "EUR")
+	got, err := currency.SpellOut(amount, currency.NewLocale("it-CH"))
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if got != "10 virgola 50 EUR" {
+		t.Errorf("got %v, want 10 virgola 50 EUR", got)
+	}
+}
+
+func TestRegisterSpeller_FromSpeller(t *testing.T) {
+	// A speller can register other spellers without deadlocking.
+	currency.RegisterSpeller("nl", currency.SpellerFunc(func(amount currency.Amount) (string, error) {
+		currency.RegisterSpeller("nl-BE", currency.SpellerFunc(func(amount currency.Amount) (string, error) {
+			return "", nil
+		}))
+		return "tien euro", nil
+	}))
+	amount, _ := currency.NewAmount("10", "EUR")
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		got, err := currency.SpellOut(amount, currency.NewLocale("nl"))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if got != "tien euro" {
+			t.Errorf("got %v, want tien euro", got)
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("SpellOut deadlocked")
+	}
+}